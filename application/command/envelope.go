@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: command
+// Description: Typed command envelope carrying cross-cutting metadata
+
+package command
+
+// Well-known metadata keys for Envelope. Values are plain strings so the
+// envelope stays serializable; timestamps use RFC 3339.
+const (
+	MetaRequestID  = "request_id"
+	MetaSource     = "source"
+	MetaReceivedAt = "received_at"
+)
+
+// Envelope wraps a command DTO with a metadata map so cross-cutting
+// concerns (logging, metrics, tracing) have context without adding fields
+// to the core DTO.
+//
+// Envelope is immutable: Wrap and WithMeta copy the metadata, so neither
+// the caller's map nor an earlier envelope is affected by later changes.
+//
+// Usage:
+//
+//	env := command.Wrap(command.NewGreetCommand("Alice"), map[string]string{
+//		command.MetaSource: "cli",
+//	})
+//	cmd := env.Command()
+//	source, ok := env.Meta(command.MetaSource)
+type Envelope[T any] struct {
+	cmd  T
+	meta map[string]string
+}
+
+// Wrap creates an Envelope around cmd with a copy of meta (nil is allowed).
+func Wrap[T any](cmd T, meta map[string]string) Envelope[T] {
+	return Envelope[T]{cmd: cmd, meta: copyMeta(meta)}
+}
+
+// Command returns the wrapped command DTO.
+func (e Envelope[T]) Command() T {
+	return e.cmd
+}
+
+// Meta returns the metadata value for key and whether it was present.
+func (e Envelope[T]) Meta(key string) (string, bool) {
+	value, ok := e.meta[key]
+	return value, ok
+}
+
+// Metadata returns a copy of all metadata (never nil).
+func (e Envelope[T]) Metadata() map[string]string {
+	return copyMeta(e.meta)
+}
+
+// WithMeta returns a copy of the envelope with key set to value.
+func (e Envelope[T]) WithMeta(key, value string) Envelope[T] {
+	e.meta = copyMeta(e.meta)
+	e.meta[key] = value
+	return e
+}
+
+// copyMeta returns a non-nil copy of meta.
+func copyMeta(meta map[string]string) map[string]string {
+	out := make(map[string]string, len(meta))
+	for k, v := range meta {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package command_test
+
+import (
+	"testing"
+
+	"github.com/abitofhelp/hybrid_app_go/application/command"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+)
+
+// TestApplicationCommandEnvelope tests the typed command envelope.
+func TestApplicationCommandEnvelope(t *testing.T) {
+	tf := test.New("Application.Command.Envelope")
+
+	// ========================================================================
+	// Test: Round-trip a GreetCommand with metadata
+	// ========================================================================
+
+	cmd := command.NewGreetCommand("Alice").WithTimes(2).WithCase("upper")
+	env := command.Wrap(cmd, map[string]string{
+		command.MetaRequestID:  "req-42",
+		command.MetaSource:     "cli",
+		command.MetaReceivedAt: "2025-01-02T03:04:05Z",
+	})
+
+	tf.AssertEqual("Command - round trips", env.Command(), cmd)
+	requestID, ok := env.Meta(command.MetaRequestID)
+	tf.RunTest("Meta request_id - present", ok)
+	tf.AssertEqual("Meta request_id - value", requestID, "req-42")
+	source, _ := env.Meta(command.MetaSource)
+	tf.AssertEqual("Meta source - value", source, "cli")
+	receivedAt, _ := env.Meta(command.MetaReceivedAt)
+	tf.AssertEqual("Meta received_at - value", receivedAt, "2025-01-02T03:04:05Z")
+	_, ok = env.Meta("missing")
+	tf.RunTest("Meta missing - absent", !ok)
+	tf.AssertEqual("Metadata - all entries", len(env.Metadata()), 3)
+
+	// ========================================================================
+	// Test: Envelope is immutable
+	// ========================================================================
+
+	meta := map[string]string{command.MetaSource: "cli"}
+	wrapped := command.Wrap(command.NewGreetCommand("Bob"), meta)
+	meta[command.MetaSource] = "http"
+	source, _ = wrapped.Meta(command.MetaSource)
+	tf.AssertEqual("Wrap - copies caller map", source, "cli")
+
+	wrapped.Metadata()[command.MetaSource] = "grpc"
+	source, _ = wrapped.Meta(command.MetaSource)
+	tf.AssertEqual("Metadata - returns copy", source, "cli")
+
+	tagged := wrapped.WithMeta(command.MetaRequestID, "req-7")
+	_, ok = wrapped.Meta(command.MetaRequestID)
+	tf.RunTest("WithMeta - original unchanged", !ok)
+	requestID, _ = tagged.Meta(command.MetaRequestID)
+	tf.AssertEqual("WithMeta - sets value", requestID, "req-7")
+	tf.AssertEqual("WithMeta - command preserved", tagged.Command().GetName(), "Bob")
+
+	// ========================================================================
+	// Test: Nil metadata
+	// ========================================================================
+
+	bare := command.Wrap(command.NewLocalesCommand(), nil)
+	tf.RunTest("Nil meta - Metadata non-nil", bare.Metadata() != nil)
+	tf.AssertEqual("Nil meta - WithMeta works", len(bare.WithMeta("k", "v").Metadata()), 1)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
@@ -22,6 +22,16 @@
 //	result := greetUseCase.Execute(cmd)
 package command
 
+import (
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/valueobject"
+)
+
+// MaxTimes is the largest Times the greet use case accepts, so a single
+// command cannot flood the writer.
+const MaxTimes = 100
+
 // GreetCommand is a Data Transfer Object for the greet use case.
 //
 // This DTO crosses the presentation -> application boundary. It may carry
@@ -29,22 +39,91 @@ package command
 // and returning appropriate Result errors.
 //
 // Design Notes:
-//   - Simple data structure (no methods except accessors)
-//   - No validation logic (validation is in domain layer)
+//   - Simple data structure (accessors plus a cheap Validate pre-check)
+//   - Full validation is in the domain layer (source of truth)
 //   - Separates external API from internal domain model
 type GreetCommand struct {
 	Name string
+
+	// Times is how many times the greeting is written. NewGreetCommand
+	// sets it to 1; the use case rejects values below 1 (including an
+	// unset zero) and above MaxTimes.
+	Times int
+
+	// Case is the letter case applied to the name: "upper", "lower",
+	// "title", or "none"/"" (unchanged). The use case rejects other values.
+	Case string
+
+	// Punctuation ends the greeting. None (the zero value) means the
+	// default "!"; Some("") means none. The domain rejects more than a
+	// couple of printable characters.
+	Punctuation valueobject.Option[string]
 }
 
-// NewGreetCommand creates a new GreetCommand DTO from a name string.
+// NewGreetCommand creates a new GreetCommand DTO from a name string,
+// greeting once with the default "!" punctuation.
 //
 // This function does not perform validation; it simply packages the raw
 // input. Validation is performed in domain.Person.CreatePerson via Result.
 func NewGreetCommand(name string) GreetCommand {
-	return GreetCommand{Name: name}
+	return GreetCommand{Name: name, Times: 1}
+}
+
+// WithTimes returns a copy of the command that greets n times.
+func (c GreetCommand) WithTimes(n int) GreetCommand {
+	c.Times = n
+	return c
+}
+
+// WithCase returns a copy of the command that applies the named letter case.
+func (c GreetCommand) WithCase(mode string) GreetCommand {
+	c.Case = mode
+	return c
+}
+
+// WithPunctuation returns a copy of the command that ends the greeting
+// with punctuation.
+func (c GreetCommand) WithPunctuation(punctuation string) GreetCommand {
+	c.Punctuation = valueobject.Some(punctuation)
+	return c
 }
 
 // GetName extracts the name as a string.
 func (c GreetCommand) GetName() string {
 	return c.Name
 }
+
+// GetTimes returns how many times the greeting should be written.
+func (c GreetCommand) GetTimes() int {
+	return c.Times
+}
+
+// GetCase returns the requested letter case for the name.
+func (c GreetCommand) GetCase() string {
+	return c.Case
+}
+
+// GetPunctuation returns the punctuation that ends the greeting, or
+// valueobject.DefaultPunctuation ("!") if none was set.
+func (c GreetCommand) GetPunctuation() string {
+	return c.Punctuation.UnwrapOr(valueobject.DefaultPunctuation)
+}
+
+// Validate performs a cheap presence check on the DTO.
+//
+// This is an OPTIMIZATION, not a replacement for domain validation: it lets
+// a driving adapter reject obviously-bad input before invoking the use case.
+// The domain (valueobject.CreatePerson) remains the source of truth for the
+// full rules (length limits, etc.), and the use case still applies them.
+//
+// Validate is idempotent and has no side effects.
+//
+// Contract:
+//   - Post: Returns Ok(Unit) if Name is non-empty
+//   - Post: Returns Err(ValidationError) if Name is empty
+func (c GreetCommand) Validate() domerr.Result[model.Unit] {
+	if c.Name == "" {
+		return domerr.Err[model.Unit](domerr.NewValidationError("name is required"))
+	}
+	return domerr.Ok(model.UnitValue)
+}
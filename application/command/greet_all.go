@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: command
+// Description: DTO for the batch greet use case
+
+package command
+
+// GreetAllCommand is a Data Transfer Object for the batch greet use case.
+//
+// Like GreetCommand it carries raw, unvalidated input; the domain validates
+// each name when the use case runs.
+type GreetAllCommand struct {
+	Names []string
+}
+
+// NewGreetAllCommand creates a new GreetAllCommand DTO from a list of names.
+//
+// The slice is copied so later changes by the caller don't leak into the DTO.
+func NewGreetAllCommand(names []string) GreetAllCommand {
+	return GreetAllCommand{Names: append([]string(nil), names...)}
+}
+
+// GetNames returns a copy of the names to greet, in order.
+func (c GreetAllCommand) GetNames() []string {
+	return append([]string(nil), c.Names...)
+}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package command_test
+
+import (
+	"testing"
+
+	"github.com/abitofhelp/hybrid_app_go/application/command"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+)
+
+// TestApplicationCommandGreet tests the GreetCommand DTO.
+func TestApplicationCommandGreet(t *testing.T) {
+	tf := test.New("Application.Command.Greet")
+
+	// ========================================================================
+	// Test: Accessor returns raw input
+	// ========================================================================
+
+	tf.AssertEqual("GetName - returns raw name",
+		command.NewGreetCommand("Alice").GetName(), "Alice")
+	tf.AssertEqual("GetTimes - defaults to 1",
+		command.NewGreetCommand("Alice").GetTimes(), 1)
+
+	once := command.NewGreetCommand("Alice")
+	thrice := once.WithTimes(3)
+	tf.AssertEqual("WithTimes - sets count", thrice.GetTimes(), 3)
+	tf.AssertEqual("WithTimes - original unchanged", once.GetTimes(), 1)
+	tf.AssertEqual("GetTimes - zero value stays zero",
+		command.GreetCommand{Name: "Alice"}.GetTimes(), 0)
+	tf.AssertEqual("GetTimes - zero from WithTimes passed through", once.WithTimes(0).GetTimes(), 0)
+	tf.AssertEqual("GetTimes - negative passed through", once.WithTimes(-1).GetTimes(), -1)
+	tf.AssertEqual("GetCase - defaults to empty", once.GetCase(), "")
+	tf.AssertEqual("WithCase - sets mode", once.WithCase("title").GetCase(), "title")
+	tf.AssertEqual("GetPunctuation - defaults to '!'", once.GetPunctuation(), "!")
+	tf.AssertEqual("WithPunctuation - sets value", once.WithPunctuation("").GetPunctuation(), "")
+	tf.AssertEqual("WithPunctuation - original unchanged", once.GetPunctuation(), "!")
+	tf.AssertEqual("GetPunctuation - zero value means '!'",
+		command.GreetCommand{Name: "Alice"}.GetPunctuation(), "!")
+
+	// ========================================================================
+	// Test: Validate accepts non-empty names
+	// ========================================================================
+
+	tf.RunTest("Validate non-empty - IsOk",
+		command.NewGreetCommand("Alice").Validate().IsOk())
+	tf.RunTest("Validate whitespace - IsOk (domain preserves whitespace)",
+		command.NewGreetCommand("   ").Validate().IsOk())
+
+	// ========================================================================
+	// Test: Validate rejects empty names
+	// ========================================================================
+
+	empty := command.NewGreetCommand("")
+	tf.AssertError("Validate empty - ValidationError", empty.Validate(), domerr.ValidationError)
+	if r := empty.Validate(); r.IsError() {
+		tf.AssertEqual("Validate empty - message", r.ErrorInfo().Message, "name is required")
+	}
+
+	// ========================================================================
+	// Test: Validate is idempotent
+	// ========================================================================
+
+	tf.RunTest("Validate - idempotent", empty.Validate() == empty.Validate())
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
+
+// TestApplicationCommandGreetAll tests the GreetAllCommand DTO.
+func TestApplicationCommandGreetAll(t *testing.T) {
+	tf := test.New("Application.Command.GreetAll")
+
+	// ========================================================================
+	// Test: Names are copied in and out
+	// ========================================================================
+
+	names := []string{"Alice", "Bob"}
+	cmd := command.NewGreetAllCommand(names)
+	names[0] = "Mallory"
+	tf.AssertEqual("Constructor copies input", cmd.GetNames(), []string{"Alice", "Bob"})
+
+	got := cmd.GetNames()
+	got[1] = "Eve"
+	tf.AssertEqual("GetNames returns a copy", cmd.GetNames(), []string{"Alice", "Bob"})
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
@@ -0,0 +1,18 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: command
+// Description: DTO for the health check use case
+
+package command
+
+// HealthCommand is the (empty) Data Transfer Object for the health use case.
+//
+// It exists so the health check follows the same Execute(ctx, cmd) shape
+// as every other use case; future probes (e.g., readiness details) can add
+// fields without changing the port signature.
+type HealthCommand struct{}
+
+// NewHealthCommand creates a new HealthCommand DTO.
+func NewHealthCommand() HealthCommand {
+	return HealthCommand{}
+}
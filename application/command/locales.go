@@ -0,0 +1,16 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: command
+// Description: DTO for the list-locales use case
+
+package command
+
+// LocalesCommand is the (empty) Data Transfer Object for listing the
+// supported greeting locales, following the same Execute(ctx, cmd) shape
+// as HealthCommand.
+type LocalesCommand struct{}
+
+// NewLocalesCommand creates a new LocalesCommand DTO.
+func NewLocalesCommand() LocalesCommand {
+	return LocalesCommand{}
+}
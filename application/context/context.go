@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: context
+// Description: Request-scoped values carried through context.Context
+
+// Package context provides typed helpers for request-scoped values that
+// flow through context.Context across layer boundaries (e.g., request IDs
+// for correlating output and errors, or a tenant tag prefixed to greetings).
+//
+// Architecture Notes:
+//   - Part of the APPLICATION layer
+//   - Presentation sets values (it owns the request); Application and
+//     Infrastructure read them
+//   - Keys are unexported types, so values cannot collide with other packages
+//
+// Usage:
+//
+//	import appctx "github.com/abitofhelp/hybrid_app_go/application/context"
+//
+//	ctx = appctx.WithRequestID(ctx, "req-42")
+//	if id, ok := appctx.RequestIDFrom(ctx); ok {
+//	    // include id in diagnostics
+//	}
+package context
+
+import "context"
+
+// requestIDKey is the unexported context key for the request ID.
+type requestIDKey struct{}
+
+// WithRequestID returns a copy of ctx carrying the given request ID.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFrom returns the request ID carried by ctx.
+//
+// Contract:
+//   - Post: Returns ("", false) if no request ID was set or it is empty
+func RequestIDFrom(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDKey{}).(string)
+	if !ok || id == "" {
+		return "", false
+	}
+	return id, true
+}
+
+// tenantKey is the unexported context key for the tenant tag.
+type tenantKey struct{}
+
+// WithTenant returns a copy of ctx carrying the given tenant tag.
+// GreetUseCase prefixes greetings with "[tenant] " when one is present.
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// TenantFrom returns the tenant tag carried by ctx.
+//
+// Contract:
+//   - Post: Returns ("", false) if no tenant was set or it is empty
+func TenantFrom(ctx context.Context) (string, bool) {
+	tenant, ok := ctx.Value(tenantKey{}).(string)
+	if !ok || tenant == "" {
+		return "", false
+	}
+	return tenant, true
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package context_test
+
+import (
+	"context"
+	"testing"
+
+	appctx "github.com/abitofhelp/hybrid_app_go/application/context"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+)
+
+// TestApplicationContextRequestID tests request ID round-tripping.
+func TestApplicationContextRequestID(t *testing.T) {
+	tf := test.New("Application.Context.RequestID")
+
+	// ========================================================================
+	// Test: Round-trip through context
+	// ========================================================================
+
+	ctx := appctx.WithRequestID(context.Background(), "req-42")
+	id, ok := appctx.RequestIDFrom(ctx)
+	tf.RunTest("Round-trip - found", ok)
+	tf.AssertEqual("Round-trip - value preserved", id, "req-42")
+
+	// ========================================================================
+	// Test: Survives derived contexts
+	// ========================================================================
+
+	derived, cancel := context.WithCancel(ctx)
+	defer cancel()
+	id, ok = appctx.RequestIDFrom(derived)
+	tf.RunTest("Derived context - still found", ok && id == "req-42")
+
+	// ========================================================================
+	// Test: Absent and empty IDs
+	// ========================================================================
+
+	_, ok = appctx.RequestIDFrom(context.Background())
+	tf.RunTest("Absent - not found", !ok)
+
+	_, ok = appctx.RequestIDFrom(appctx.WithRequestID(context.Background(), ""))
+	tf.RunTest("Empty ID - treated as absent", !ok)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
+
+// TestApplicationContextTenant tests tenant round-tripping.
+func TestApplicationContextTenant(t *testing.T) {
+	tf := test.New("Application.Context.Tenant")
+
+	tenant, ok := appctx.TenantFrom(appctx.WithTenant(context.Background(), "acme"))
+	tf.RunTest("Round-trip - found", ok)
+	tf.AssertEqual("Round-trip - value preserved", tenant, "acme")
+
+	_, ok = appctx.TenantFrom(context.Background())
+	tf.RunTest("Absent - not found", !ok)
+
+	_, ok = appctx.TenantFrom(appctx.WithTenant(context.Background(), ""))
+	tf.RunTest("Empty tenant - treated as absent", !ok)
+
+	_, ok = appctx.TenantFrom(appctx.WithRequestID(context.Background(), "acme"))
+	tf.RunTest("Request ID - not mistaken for tenant", !ok)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
@@ -44,18 +44,44 @@ type ErrorKind = domerr.ErrorKind
 const (
 	ValidationError     = domerr.ValidationError
 	InfrastructureError = domerr.InfrastructureError
+	NotFoundError       = domerr.NotFoundError
 )
 
 // ErrorType is the concrete error type (re-exported from domain)
 type ErrorType = domerr.ErrorType
 
+// MultiError collects several errors from one operation (re-exported from domain)
+type MultiError = domerr.MultiError
+
+// ResultState discriminates Ok from Err for switch statements (re-exported from domain)
+type ResultState = domerr.ResultState
+
+// Result state constants (re-exported from domain)
+const (
+	StateOk  = domerr.StateOk
+	StateErr = domerr.StateErr
+)
+
 // Result is the Result monad type (re-exported from domain)
-// Presentation layer uses this type but does not create Results
-// (Results are created by Application layer and passed to Presentation)
+// Presentation layer mostly consumes Results created by the Application layer;
+// Ok/Err below exist for presentation-side helpers and test doubles.
 type Result[T any] = domerr.Result[T]
 
+// Ok creates a successful Result (forwards to domain; generic functions
+// cannot be re-exported as variables).
+func Ok[T any](value T) Result[T] {
+	return domerr.Ok(value)
+}
+
+// Err creates an error Result (forwards to domain).
+func Err[T any](err ErrorType) Result[T] {
+	return domerr.Err[T](err)
+}
+
 // Constructor functions (re-exported from domain)
 var (
 	NewValidationError     = domerr.NewValidationError
 	NewInfrastructureError = domerr.NewInfrastructureError
+	NewNotFoundError       = domerr.NewNotFoundError
+	NewMultiError          = domerr.NewMultiError
 )
@@ -0,0 +1,17 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: model
+// Description: Summary of a lenient (streaming or partial batch) greet run
+
+package model
+
+// GreetSummary reports the outcome of greeting a stream or batch of names
+// (GreetStreamUseCase.Execute, GreetAllUseCase.ExecutePartial).
+//
+// Invalid names do not stop the run; they are counted in Skipped and
+// described in Errors (one entry per skipped name, in input order).
+type GreetSummary struct {
+	Greeted int
+	Skipped int
+	Errors  []string
+}
@@ -0,0 +1,16 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: model
+// Description: Description of a supported greeting locale
+
+package model
+
+// LocaleInfo describes one supported greeting locale for display, so
+// presentation can list locales without importing the domain.
+type LocaleInfo struct {
+	// Code is the language code accepted by the domain (e.g., "es").
+	Code string
+
+	// Sample is a greeting rendered in this locale (e.g., "¡Hola, Alice!").
+	Sample string
+}
@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: inbound
+// Description: Input port for the batch greet use case
+
+package inbound
+
+import (
+	"context"
+
+	"github.com/abitofhelp/hybrid_app_go/application/command"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+// GreetAllPort is an input port contract for greeting a batch of names
+// with partial success: valid names are greeted, invalid ones skipped.
+//
+// GreetAllUseCase implements it via ExecutePartial; driving adapters
+// (e.g., the CLI's greet-all command) are generic over this port.
+//
+// Contract:
+//   - Returns Ok(summary) once every name was either greeted or skipped;
+//     the summary counts both and describes each skipped name
+//   - Returns Err(ValidationError) if the batch as a whole is rejected
+//     (e.g., too many names)
+//   - Returns Err(InfrastructureError) if a write failed or ctx was cancelled
+type GreetAllPort interface {
+	ExecutePartial(ctx context.Context, cmd command.GreetAllCommand) domerr.Result[model.GreetSummary]
+}
@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: inbound
+// Description: Input port for the interactive greet use case
+
+package inbound
+
+import (
+	"context"
+
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+// GreetInteractivePort is an input port contract for greeting names as a
+// user types them, until end of input or a blank line.
+//
+// GreetStreamUseCase implements it via ExecuteInteractive; the CLI's
+// --interactive mode is generic over this port.
+//
+// Contract:
+//   - Returns Ok(summary) at EOF or on the first blank line; the summary
+//     counts greeted and skipped names and describes each skipped one
+//   - Returns Err(InfrastructureError) if a read or write failed or ctx
+//     was cancelled
+type GreetInteractivePort interface {
+	ExecuteInteractive(ctx context.Context) domerr.Result[model.GreetSummary]
+}
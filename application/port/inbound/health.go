@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: inbound
+// Description: Input port for the health check use case
+
+package inbound
+
+import (
+	"context"
+
+	"github.com/abitofhelp/hybrid_app_go/application/command"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+// HealthPort is an input port contract for liveness checks.
+//
+// Static Dispatch:
+//   - Used as a generic type parameter: HealthHandler[UC HealthPort]
+//
+// Contract:
+//   - Returns Ok(Unit) if the application can serve requests
+//   - Returns Err(InfrastructureError) if it cannot (e.g., ctx cancelled)
+type HealthPort interface {
+	Execute(ctx context.Context, cmd command.HealthCommand) domerr.Result[model.Unit]
+}
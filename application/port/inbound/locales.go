@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: inbound
+// Description: Input port for the list-locales use case
+
+package inbound
+
+import (
+	"context"
+
+	"github.com/abitofhelp/hybrid_app_go/application/command"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+// LocalesPort is an input port contract for discovering supported locales.
+//
+// Static Dispatch:
+//   - Used as a generic type parameter: LocalesCommand[UC LocalesPort]
+//
+// Contract:
+//   - Returns Ok(locales) sorted by code, each with a sample greeting
+//   - Returns Err(InfrastructureError) if ctx is cancelled
+type LocalesPort interface {
+	Execute(ctx context.Context, cmd command.LocalesCommand) domerr.Result[[]model.LocaleInfo]
+}
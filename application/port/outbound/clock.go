@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: outbound
+// Description: Output port for reading the current time
+
+package outbound
+
+import "time"
+
+// ClockPort is an output port contract for reading the current time.
+//
+// Injecting the clock (rather than calling time.Now directly) keeps
+// time-dependent adapters and use cases deterministic under test.
+//
+// Static Dispatch:
+//   - Used as a generic type parameter: TimestampWriter[W WriterPort, C ClockPort]
+//   - Production wires adapter.SystemClock; tests wire a fixed clock
+//
+// Contract:
+//   - Now returns the current instant; must not panic
+type ClockPort interface {
+	Now() time.Time
+}
@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: outbound
+// Description: Output port for delivering domain events
+
+package outbound
+
+import (
+	"context"
+
+	"github.com/abitofhelp/hybrid_app_go/domain/event"
+)
+
+// EventSinkFunc is an output port for domain events raised by use cases
+// (currently event.PersonGreeted, after a successful greeting).
+//
+// Optional Dependency:
+//   - Like MetricsPort and LoggerPort, sinks are injected as options
+//     (usecase.WithEventSink); the default is a no-op
+//
+// Contract:
+//   - Called synchronously, after the operation succeeded; a sink that
+//     does slow work should hand the event off rather than block
+//   - Must be safe for concurrent use and must not panic
+type EventSinkFunc func(ctx context.Context, e event.PersonGreeted)
@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: outbound
+// Description: Output port for inferring a person's honorific
+
+package outbound
+
+// HonorificFunc is an output port that infers an honorific (e.g., "Dr.",
+// "Prof.") for a validated name, returning false when none applies.
+//
+// Inference (a directory lookup, a staff table) is an application concern
+// kept out of the domain: the use case passes the honorific to the domain
+// as the Person's title, so GetName() is unchanged and the greeting reads
+// "Hello, Dr. Alice!".
+//
+// Optional Dependency:
+//   - Injected as an option (usecase.WithHonorific); by default no
+//     honorific is inferred
+//
+// Contract:
+//   - A returned honorific must satisfy the domain title rules (non-empty,
+//     at most valueobject.MaxTitleLength), or the greeting fails validation
+//   - Must be safe for concurrent use and must not panic
+type HonorificFunc func(name string) (string, bool)
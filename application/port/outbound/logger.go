@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: outbound
+// Description: Output port for structured, request-correlated log entries
+
+package outbound
+
+import (
+	"context"
+
+	appctx "github.com/abitofhelp/hybrid_app_go/application/context"
+)
+
+// Layer names used to tag log entries with their origin.
+const (
+	LayerApplication    = "application"
+	LayerInfrastructure = "infrastructure"
+)
+
+// LogEntry is one log record.
+type LogEntry struct {
+	// Layer is the architectural layer that logged (see Layer*).
+	Layer string
+
+	// RequestID correlates entries from one request across layers;
+	// empty if the context carried none.
+	RequestID string
+
+	Message string
+}
+
+// LoggerPort is an output port contract for receiving log entries.
+//
+// Code does not call Log directly; it logs through a LoggerFunc from
+// LogWith, which fills in Layer and RequestID so every entry is tagged
+// consistently.
+//
+// Optional Dependency:
+//   - Like MetricsPort, loggers are injected as options; the default
+//     is a no-op
+//
+// Contract:
+//   - Must be safe for concurrent use and must not panic
+type LoggerPort interface {
+	Log(entry LogEntry)
+}
+
+// LoggerFunc logs message with the entry fields derived from ctx.
+type LoggerFunc func(ctx context.Context, message string)
+
+// LogWith returns a LoggerFunc that sends entries to sink tagged with
+// layer and the request ID carried by ctx (see appctx.WithRequestID).
+//
+// Usage:
+//
+//	log := outbound.LogWith(sink, outbound.LayerApplication)
+//	log(ctx, "greet failed: Person name cannot be empty")
+//	// sink receives LogEntry{Layer: "application", RequestID: "req-42", ...}
+func LogWith(sink LoggerPort, layer string) LoggerFunc {
+	return func(ctx context.Context, message string) {
+		id, _ := appctx.RequestIDFrom(ctx)
+		sink.Log(LogEntry{Layer: layer, RequestID: id, Message: message})
+	}
+}
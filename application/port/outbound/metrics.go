@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: outbound
+// Description: Output port for recording metrics counters
+
+package outbound
+
+import "time"
+
+// MetricsPort is an output port contract for incrementing named counters.
+//
+// Use cases report what happened (e.g., "greet.success"); infrastructure
+// decides where counters live (in-memory registry, Prometheus, StatsD...).
+//
+// Optional Dependency:
+//   - Metrics are injected as an option, not a type parameter, so wiring
+//     them never changes a use case's type; the default is a no-op
+//
+// Contract:
+//   - IncrementCounter adds 1 to the counter identified by name and tags
+//   - tags may be nil; implementations must not retain or mutate the map
+//   - Must be safe for concurrent use and must not panic
+type MetricsPort interface {
+	IncrementCounter(name string, tags map[string]string)
+}
+
+// TimingPort is an output port contract for recording durations.
+//
+// Kept separate from MetricsPort so counter-only sinks need not implement
+// it; a registry that supports both simply implements both.
+//
+// Contract:
+//   - RecordDuration records one observation of d for name and tags
+//   - tags may be nil; implementations must not retain or mutate the map
+//   - Must be safe for concurrent use and must not panic
+type TimingPort interface {
+	RecordDuration(name string, d time.Duration, tags map[string]string)
+}
+
+// HistogramPort is an output port contract for recording observed values
+// (e.g., sizes), for histograms or gauges.
+//
+// Like TimingPort, it is separate from MetricsPort so counter-only sinks
+// need not implement it.
+//
+// Contract:
+//   - RecordValue records one observation of value for name and tags
+//   - tags may be nil; implementations must not retain or mutate the map
+//   - Must be safe for concurrent use and must not panic
+type HistogramPort interface {
+	RecordValue(name string, value float64, tags map[string]string)
+}
@@ -0,0 +1,20 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: outbound
+// Description: Output port for random choices
+
+package outbound
+
+// RandFunc is an output port that returns a non-negative pseudo-random int.
+//
+// Any use case that varies its output at random draws from an injected
+// RandFunc rather than calling math/rand directly, so tests can fix the
+// sequence and assert every branch deterministically.
+//
+// Optional Dependency:
+//   - Injected as an option (usecase.WithRand); the default is math/rand's
+//     rand.Int
+//
+// Contract:
+//   - Returns a value >= 0; must be safe for concurrent use and must not panic
+type RandFunc func() int
@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: outbound
+// Description: Output port for reading input line by line
+
+package outbound
+
+import (
+	"context"
+
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/valueobject"
+)
+
+// ReaderPort is an output port contract for reading input one line at a time.
+//
+// End of input is a normal outcome, not an error, so it is modelled as
+// Ok(None) rather than Err.
+//
+// Static Dispatch:
+//   - Used as a generic type parameter: GreetStreamUseCase[R ReaderPort, W WriterPort]
+//
+// Contract:
+//   - Returns Ok(Some(line)) for each line, without the line terminator
+//   - Returns Ok(None) once input is exhausted (and on every later call)
+//   - Returns Err(InfrastructureError) on I/O failure or context cancellation
+//   - Must not panic
+type ReaderPort interface {
+	ReadLine(ctx context.Context) domerr.Result[valueobject.Option[string]]
+}
@@ -0,0 +1,181 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: usecase
+// Description: Greet use case that caches validated greetings (LRU)
+
+package usecase
+
+import (
+	"container/list"
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/abitofhelp/hybrid_app_go/application/command"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	"github.com/abitofhelp/hybrid_app_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/event"
+	"github.com/abitofhelp/hybrid_app_go/domain/valueobject"
+)
+
+// DefaultGreetCacheSize is the number of greetings CachedGreetUseCase
+// keeps unless a positive capacity is given.
+const DefaultGreetCacheSize = 256
+
+// greetCacheKey identifies a rendered greeting. The name is used exactly
+// as given (the domain preserves whitespace, so trimming or folding it
+// could change the greeting); the case is normalized the way the domain
+// parses it, so "", " none", and "NONE" share an entry.
+type greetCacheKey struct {
+	name        string
+	nameCase    string
+	punctuation string
+}
+
+// newGreetCacheKey returns the normalized cache key for cmd.
+func newGreetCacheKey(cmd command.GreetCommand) greetCacheKey {
+	nameCase := strings.ToLower(strings.TrimSpace(cmd.GetCase()))
+	if nameCase == "" {
+		nameCase = valueobject.CaseNone.String()
+	}
+	return greetCacheKey{name: cmd.GetName(), nameCase: nameCase, punctuation: cmd.GetPunctuation()}
+}
+
+// cachedGreeting is a validated Person and its rendered greeting.
+type cachedGreeting struct {
+	person   valueobject.Person
+	greeting string
+}
+
+// greetCacheEntry is the value stored in the LRU list.
+type greetCacheEntry struct {
+	key   greetCacheKey
+	value cachedGreeting
+}
+
+// CachedGreetUseCase is GreetUseCase with a bounded in-memory cache of
+// validated greetings, for batch input with many repeated names.
+//
+// A cache hit skips domain validation and greeting rendering; the writer,
+// tenant prefix, repetition, metrics, logging, and events are applied on
+// every call exactly as in GreetUseCase. Only valid greetings are cached,
+// so invalid input is re-validated (and reported) every time. When full,
+// the least recently used entry is evicted.
+//
+// Safe for concurrent use; two concurrent misses for the same key may both
+// render, and the later result replaces the earlier one.
+//
+// Implements: inbound.GreetPort interface
+type CachedGreetUseCase[W outbound.WriterPort] struct {
+	writer    W
+	hasWriter bool
+	opts      greetOptions
+
+	mu       sync.Mutex
+	capacity int
+	entries  map[greetCacheKey]*list.Element
+	lru      *list.List // front: most recently used
+}
+
+// NewCachedGreetUseCase creates a CachedGreetUseCase holding at most
+// capacity greetings (values below 1 use DefaultGreetCacheSize). It accepts
+// the same options as NewGreetUseCase.
+func NewCachedGreetUseCase[W outbound.WriterPort](writer W, capacity int, opts ...GreetOption) *CachedGreetUseCase[W] {
+	if capacity < 1 {
+		capacity = DefaultGreetCacheSize
+	}
+	return &CachedGreetUseCase[W]{
+		writer:    writer,
+		hasWriter: !isNilWriter(writer),
+		opts:      newGreetOptions(opts),
+		capacity:  capacity,
+		entries:   make(map[greetCacheKey]*list.Element, capacity),
+		lru:       list.New(),
+	}
+}
+
+// Execute greets cmd like GreetUseCase.Execute, reusing a cached greeting
+// when the same name, case, and punctuation were greeted before.
+//
+// Contract:
+//   - Post: Same results, output, metrics, and events as GreetUseCase.Execute
+//   - Post: On a cache hit, the domain and greeting strategy are not called
+//   - Post: Len() <= Cap() after every call
+func (uc *CachedGreetUseCase[W]) Execute(ctx context.Context, cmd command.GreetCommand) domerr.Result[model.Unit] {
+	result := domerr.AndThenTo(uc.lookup(cmd), func(cached cachedGreeting) domerr.Result[model.Unit] {
+		if !uc.hasWriter {
+			return domerr.Err[model.Unit](domerr.NewInfrastructureError("writer not configured"))
+		}
+		message := withTenantPrefix(ctx, cached.greeting)
+		uc.opts.observeGreeting(ctx, message)
+		return writeTimes(ctx, uc.writer, message, cmd.GetTimes()).Inspect(func(model.Unit) {
+			uc.opts.events(ctx, event.NewPersonGreeted(cached.person, uc.opts.clock.Now()))
+		})
+	})
+
+	uc.opts.metrics.IncrementCounter(outcomeMetric(result), nil)
+	return result.InspectErr(func(e domerr.ErrorType) {
+		uc.opts.log(ctx, "greet failed: "+e.Message)
+	})
+}
+
+// Len returns the number of cached greetings.
+func (uc *CachedGreetUseCase[W]) Len() int {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	return uc.lru.Len()
+}
+
+// Cap returns the maximum number of cached greetings.
+func (uc *CachedGreetUseCase[W]) Cap() int {
+	return uc.capacity
+}
+
+// lookup returns the cached greeting for cmd, rendering and caching it on
+// a miss. Failed renders are not cached.
+func (uc *CachedGreetUseCase[W]) lookup(cmd command.GreetCommand) domerr.Result[cachedGreeting] {
+	key := newGreetCacheKey(cmd)
+
+	uc.mu.Lock()
+	if el, ok := uc.entries[key]; ok {
+		uc.lru.MoveToFront(el)
+		cached := el.Value.(*greetCacheEntry).value
+		uc.mu.Unlock()
+		return domerr.Ok(cached)
+	}
+	uc.mu.Unlock()
+
+	return uc.renderGreeting(cmd).Inspect(func(cached cachedGreeting) {
+		uc.store(key, cached)
+	})
+}
+
+// store caches value under key as the most recently used entry, evicting
+// the least recently used one if the cache is full.
+func (uc *CachedGreetUseCase[W]) store(key greetCacheKey, value cachedGreeting) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	if el, ok := uc.entries[key]; ok {
+		el.Value.(*greetCacheEntry).value = value
+		uc.lru.MoveToFront(el)
+		return
+	}
+	if uc.lru.Len() >= uc.capacity {
+		oldest := uc.lru.Back()
+		uc.lru.Remove(oldest)
+		delete(uc.entries, oldest.Value.(*greetCacheEntry).key)
+	}
+	uc.entries[key] = uc.lru.PushFront(&greetCacheEntry{key: key, value: value})
+}
+
+// renderGreeting validates cmd via the domain and renders its greeting,
+// exactly as GreetUseCase.Execute does before writing.
+func (uc *CachedGreetUseCase[W]) renderGreeting(cmd command.GreetCommand) domerr.Result[cachedGreeting] {
+	return domerr.AndThenTo(uc.opts.renderPerson(cmd), func(person valueobject.Person) domerr.Result[cachedGreeting] {
+		return domerr.MapTo(uc.opts.greeting(person, cmd.GetPunctuation()), func(greeting string) cachedGreeting {
+			return cachedGreeting{person: person, greeting: greeting}
+		})
+	})
+}
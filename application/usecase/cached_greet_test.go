@@ -0,0 +1,138 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package usecase_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_app_go/application/command"
+	appctx "github.com/abitofhelp/hybrid_app_go/application/context"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	"github.com/abitofhelp/hybrid_app_go/application/port/inbound"
+	"github.com/abitofhelp/hybrid_app_go/application/usecase"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+)
+
+// lockedWriter is a WriterPort test double that is safe for concurrent use.
+type lockedWriter struct {
+	mu     sync.Mutex
+	writes int
+}
+
+func (w *lockedWriter) Write(_ context.Context, _ string) domerr.Result[model.Unit] {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.writes++
+	return domerr.Ok(model.UnitValue)
+}
+
+// Compile-time check: CachedGreetUseCase is a drop-in GreetPort.
+var _ inbound.GreetPort = (*usecase.CachedGreetUseCase[*recordingWriter])(nil)
+
+// TestApplicationUseCaseCachedGreet tests the LRU greeting cache.
+func TestApplicationUseCaseCachedGreet(t *testing.T) {
+	tf := test.New("Application.UseCase.CachedGreet")
+	ctx := context.Background()
+
+	// nameRecordingGreeting is called once per validated (rendered)
+	// greeting, so it counts cache misses
+	greet := func(uc *usecase.CachedGreetUseCase[*recordingWriter], names ...string) {
+		for _, name := range names {
+			uc.Execute(ctx, command.NewGreetCommand(name))
+		}
+	}
+
+	// ========================================================================
+	// Test: Cache hit skips re-validation but still writes
+	// ========================================================================
+
+	writer := &recordingWriter{}
+	validated := &nameRecordingGreeting{}
+	uc := usecase.NewCachedGreetUseCase(writer, 4, usecase.WithGreetingService(validated))
+	greet(uc, "Alice", "Alice", "Bob", "Alice")
+	tf.AssertEqual("Hit - every greeting written", writer.messages,
+		[]string{"Hello, Alice!", "Hello, Alice!", "Hello, Bob!", "Hello, Alice!"})
+	tf.AssertEqual("Hit - each name validated once", validated.names, []string{"Alice", "Bob"})
+	tf.AssertEqual("Hit - two entries", uc.Len(), 2)
+
+	// ========================================================================
+	// Test: Key includes case and punctuation; case is normalized
+	// ========================================================================
+
+	writer = &recordingWriter{}
+	uc = usecase.NewCachedGreetUseCase(writer, 4)
+	uc.Execute(ctx, command.NewGreetCommand("alice"))
+	uc.Execute(ctx, command.NewGreetCommand("alice").WithCase("upper"))
+	uc.Execute(ctx, command.NewGreetCommand("alice").WithCase(" UPPER "))
+	uc.Execute(ctx, command.NewGreetCommand("alice").WithCase("none"))
+	uc.Execute(ctx, command.NewGreetCommand("alice").WithPunctuation("."))
+	tf.AssertEqual("Key - variants rendered separately", writer.messages,
+		[]string{"Hello, alice!", "Hello, ALICE!", "Hello, ALICE!", "Hello, alice!", "Hello, alice."})
+	tf.AssertEqual("Key - equivalent cases share an entry", uc.Len(), 3)
+
+	// ========================================================================
+	// Test: LRU eviction past capacity
+	// ========================================================================
+
+	writer = &recordingWriter{}
+	validated = &nameRecordingGreeting{}
+	uc = usecase.NewCachedGreetUseCase(writer, 2, usecase.WithGreetingService(validated))
+	greet(uc, "Alice", "Bob", "Alice", "Carol")
+	tf.AssertEqual("Evict - bounded by capacity", uc.Len(), uc.Cap())
+	validated.names = nil
+	greet(uc, "Alice", "Carol", "Bob")
+	tf.AssertEqual("Evict - least recently used (Bob) re-validated", validated.names, []string{"Bob"})
+	validated.names = nil
+	greet(uc, "Alice")
+	tf.AssertEqual("Evict - Bob evicted Alice", validated.names, []string{"Alice"})
+	tf.AssertEqual("Capacity below 1 - default", usecase.NewCachedGreetUseCase(writer, 0).Cap(),
+		usecase.DefaultGreetCacheSize)
+
+	// ========================================================================
+	// Test: Errors are not cached; tenant and count apply per call
+	// ========================================================================
+
+	writer = &recordingWriter{}
+	uc = usecase.NewCachedGreetUseCase(writer, 4)
+	tf.AssertError("Invalid - ValidationError", uc.Execute(ctx, command.NewGreetCommand("")), domerr.ValidationError)
+	tf.AssertError("Invalid again - still ValidationError", uc.Execute(ctx, command.NewGreetCommand("")), domerr.ValidationError)
+	tf.AssertEqual("Invalid - not cached", uc.Len(), 0)
+
+	uc.Execute(ctx, command.NewGreetCommand("Alice"))
+	uc.Execute(appctx.WithTenant(ctx, "acme"), command.NewGreetCommand("Alice").WithTimes(2))
+	tf.AssertEqual("Hit - tenant and count per call", writer.messages,
+		[]string{"Hello, Alice!", "[acme] Hello, Alice!", "[acme] Hello, Alice!"})
+
+	failing := &failOnCallWriter{failOn: 2}
+	failingUC := usecase.NewCachedGreetUseCase(failing, 4)
+	failingUC.Execute(ctx, command.NewGreetCommand("Alice"))
+	tf.AssertError("Hit - write failure propagates",
+		failingUC.Execute(ctx, command.NewGreetCommand("Alice")), domerr.InfrastructureError)
+
+	// ========================================================================
+	// Test: Concurrent use stays within capacity
+	// ========================================================================
+
+	sink := &lockedWriter{}
+	shared := usecase.NewCachedGreetUseCase(sink, 3)
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for _, name := range []string{"Alice", "Bob", "Carol", "Dave", "Alice"} {
+				shared.Execute(ctx, command.NewGreetCommand(name))
+			}
+		}()
+	}
+	wg.Wait()
+	tf.RunTest("Concurrent - Len <= Cap", shared.Len() <= shared.Cap())
+	tf.AssertEqual("Concurrent - every greeting written", sink.writes, 8*5)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
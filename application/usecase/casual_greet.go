@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: usecase
+// Description: Casual greet use case with a randomly chosen phrase
+
+package usecase
+
+import (
+	"context"
+
+	"github.com/abitofhelp/hybrid_app_go/application/command"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	"github.com/abitofhelp/hybrid_app_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/valueobject"
+)
+
+// casualGreetings are the phrases CasualGreetUseCase chooses between,
+// indexed by RandFunc() modulo their count.
+var casualGreetings = [...]string{"Hey", "Hi", "Yo"}
+
+// CasualGreetUseCase greets a person with a randomly chosen casual phrase
+// ("Hey, Alice!", "Hi, Alice!", or "Yo, Alice!").
+//
+// The choice comes from the injected RandFunc (WithRand), never from
+// math/rand directly, so a fixed RandFunc makes the output deterministic.
+// Validation, tenant prefix, and repetition match GreetUseCase.
+//
+// Implements: inbound.GreetPort interface
+type CasualGreetUseCase[W outbound.WriterPort] struct {
+	writer W
+	opts   greetOptions
+}
+
+// NewCasualGreetUseCase creates a new CasualGreetUseCase writing to writer.
+// Pass WithRand to control the phrase choice.
+func NewCasualGreetUseCase[W outbound.WriterPort](writer W, opts ...GreetOption) *CasualGreetUseCase[W] {
+	return &CasualGreetUseCase[W]{writer: writer, opts: newGreetOptions(opts)}
+}
+
+// Execute validates the name and writes a casual greeting.
+//
+// Contract:
+//   - Post: Returns Ok(Unit) after writing "<phrase>, <name>!" cmd.GetTimes()
+//     times, where phrase is Hey, Hi, or Yo for RandFunc() % 3 == 0, 1, 2
+//   - Post: RandFunc is called once per Execute, and only for a valid name
+//   - Post: Returns Err(ValidationError) if the name is invalid or
+//     cmd.GetTimes() < 1 (nothing is written)
+//   - Post: Returns Err(InfrastructureError) if write failed or ctx cancelled
+func (uc *CasualGreetUseCase[W]) Execute(ctx context.Context, cmd command.GreetCommand) domerr.Result[model.Unit] {
+	return domerr.AndThenTo(valueobject.CreatePerson(cmd.GetName()), func(person valueobject.Person) domerr.Result[model.Unit] {
+		message := withTenantPrefix(ctx, uc.phrase()+", "+person.GetName()+"!")
+		return writeTimes(ctx, uc.writer, message, cmd.GetTimes())
+	})
+}
+
+// phrase picks a casual greeting with the injected RandFunc. A negative
+// value (a RandFunc breaking its contract) is folded back into range.
+func (uc *CasualGreetUseCase[W]) phrase() string {
+	i := uc.opts.rand() % len(casualGreetings)
+	if i < 0 {
+		i += len(casualGreetings)
+	}
+	return casualGreetings[i]
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_app_go/application/command"
+	appctx "github.com/abitofhelp/hybrid_app_go/application/context"
+	"github.com/abitofhelp/hybrid_app_go/application/port/inbound"
+	"github.com/abitofhelp/hybrid_app_go/application/usecase"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+)
+
+// Compile-time check: CasualGreetUseCase satisfies the inbound GreetPort contract.
+var _ inbound.GreetPort = (*usecase.CasualGreetUseCase[*recordingWriter])(nil)
+
+// fixedRand returns a RandFunc that always yields n and counts its calls.
+func fixedRand(n int, calls *int) func() int {
+	return func() int {
+		*calls++
+		return n
+	}
+}
+
+// TestApplicationUseCaseCasualGreet tests phrase selection via the injected RandFunc.
+func TestApplicationUseCaseCasualGreet(t *testing.T) {
+	tf := test.New("Application.UseCase.CasualGreet")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Each RandFunc value selects its phrase deterministically
+	// ========================================================================
+
+	for _, tc := range []struct {
+		rand int
+		want string
+	}{
+		{0, "Hey, Alice!"},
+		{1, "Hi, Alice!"},
+		{2, "Yo, Alice!"},
+		{3, "Hey, Alice!"},
+		{-1, "Yo, Alice!"},
+	} {
+		writer := &recordingWriter{}
+		calls := 0
+		uc := usecase.NewCasualGreetUseCase(writer, usecase.WithRand(fixedRand(tc.rand, &calls)))
+		result := uc.Execute(ctx, command.NewGreetCommand("Alice"))
+		tf.RunTest("Phrase - IsOk for "+tc.want, result.IsOk())
+		tf.AssertEqual("Phrase - "+tc.want, writer.messages, []string{tc.want})
+		tf.AssertEqual("Phrase - RandFunc called once for "+tc.want, calls, 1)
+	}
+
+	// ========================================================================
+	// Test: Same RandFunc value, same output across runs
+	// ========================================================================
+
+	calls := 0
+	writer := &recordingWriter{}
+	uc := usecase.NewCasualGreetUseCase(writer, usecase.WithRand(fixedRand(1, &calls)))
+	uc.Execute(ctx, command.NewGreetCommand("Bob"))
+	uc.Execute(ctx, command.NewGreetCommand("Bob"))
+	tf.AssertEqual("Repeatable - identical greetings", writer.messages, []string{"Hi, Bob!", "Hi, Bob!"})
+
+	// ========================================================================
+	// Test: Times, tenant prefix, and validation match GreetUseCase
+	// ========================================================================
+
+	writer = &recordingWriter{}
+	usecase.NewCasualGreetUseCase(writer, usecase.WithRand(fixedRand(2, &calls))).
+		Execute(appctx.WithTenant(ctx, "acme"), command.NewGreetCommand("Bob").WithTimes(2))
+	tf.AssertEqual("Times and tenant - applied", writer.messages, []string{"[acme] Yo, Bob!", "[acme] Yo, Bob!"})
+
+	calls = 0
+	writer = &recordingWriter{}
+	result := usecase.NewCasualGreetUseCase(writer, usecase.WithRand(fixedRand(0, &calls))).
+		Execute(ctx, command.NewGreetCommand(""))
+	tf.AssertError("Invalid name - ValidationError", result, domerr.ValidationError)
+	tf.AssertEqual("Invalid name - nothing written", len(writer.messages), 0)
+	tf.AssertEqual("Invalid name - RandFunc not called", calls, 0)
+
+	result = usecase.NewCasualGreetUseCase(&failOnCallWriter{failOn: 1}).Execute(ctx, command.NewGreetCommand("Alice"))
+	tf.AssertError("Write failure - InfrastructureError", result, domerr.InfrastructureError)
+
+	// ========================================================================
+	// Test: Default RandFunc always yields one of the phrases
+	// ========================================================================
+
+	writer = &recordingWriter{}
+	uc = usecase.NewCasualGreetUseCase(writer, usecase.WithRand(nil))
+	for i := 0; i < 20; i++ {
+		uc.Execute(ctx, command.NewGreetCommand("Alice"))
+	}
+	unexpected := 0
+	for _, msg := range writer.messages {
+		if msg != "Hey, Alice!" && msg != "Hi, Alice!" && msg != "Yo, Alice!" {
+			unexpected++
+		}
+	}
+	tf.AssertEqual("Default - only known phrases", unexpected, 0)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
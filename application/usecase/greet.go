@@ -45,11 +45,15 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+	"reflect"
 
 	"github.com/abitofhelp/hybrid_app_go/application/command"
+	appctx "github.com/abitofhelp/hybrid_app_go/application/context"
 	"github.com/abitofhelp/hybrid_app_go/application/model"
 	"github.com/abitofhelp/hybrid_app_go/application/port/outbound"
 	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/event"
 	"github.com/abitofhelp/hybrid_app_go/domain/valueobject"
 )
 
@@ -77,7 +81,9 @@ import (
 //
 // Implements: inbound.GreetPort interface
 type GreetUseCase[W outbound.WriterPort] struct {
-	writer W
+	writer    W
+	hasWriter bool
+	opts      greetOptions
 }
 
 // NewGreetUseCase creates a new GreetUseCase with injected dependencies.
@@ -92,18 +98,43 @@ type GreetUseCase[W outbound.WriterPort] struct {
 // Mapping to Ada:
 //   - Ada: package Greet_UC is new Application.Usecase.Greet(Writer => Console_Writer.Write);
 //   - Go: uc := NewGreetUseCase[*adapter.ConsoleWriter](consoleWriter)
-func NewGreetUseCase[W outbound.WriterPort](writer W) *GreetUseCase[W] {
-	return &GreetUseCase[W]{writer: writer}
+//
+// Optional collaborators (e.g., WithMetrics, WithLogger,
+// WithGreetingService) default to no-ops or the canonical domain behaviour.
+//
+// A nil writer (nil interface, nil pointer, or nil WriterFunc) does not
+// panic: the use case is still constructed, and Execute reports
+// Err(InfrastructureError) "writer not configured" instead of writing.
+func NewGreetUseCase[W outbound.WriterPort](writer W, opts ...GreetOption) *GreetUseCase[W] {
+	return &GreetUseCase[W]{writer: writer, hasWriter: !isNilWriter(writer), opts: newGreetOptions(opts)}
+}
+
+// isNilWriter reports whether writer is a nil interface or a typed nil
+// (pointer, func, map, chan, slice) that would panic when called.
+func isNilWriter[W outbound.WriterPort](writer W) bool {
+	v := reflect.ValueOf(writer)
+	switch v.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Pointer, reflect.Func, reflect.Map, reflect.Chan, reflect.Slice, reflect.Interface:
+		return v.IsNil()
+	default:
+		return false
+	}
 }
 
 // Execute runs the greeting use case.
 //
 // Orchestration workflow:
 //  1. Extract name from GreetCommand DTO
-//  2. Validate and create Person from name (domain validation)
-//  3. Generate greeting message (application-level formatting)
-//  4. Write greeting to console via output port (STATIC DISPATCH)
+//  2. Validate and create Person from name (domain validation), applying
+//     the requested letter case (Person.WithCase)
+//  3. Obtain greeting message from the GreetingService domain service
+//  4. Write greeting to console via output port (STATIC DISPATCH),
+//     cmd.GetTimes() times, stopping at the first write failure
 //  5. Propagate any errors via railway-oriented programming
+//  6. Report the outcome as a metrics counter (see MetricGreet*)
+//  7. Log failures (see WithLogger)
 //
 // Railway-Oriented Programming:
 //   - Uses AndThenTo for functional composition across Result types
@@ -121,35 +152,111 @@ func NewGreetUseCase[W outbound.WriterPort](writer W) *GreetUseCase[W] {
 //   - cmd: GreetCommand DTO crossing presentation -> application boundary
 //
 // Error scenarios:
-//   - ValidationError: Invalid person name (empty, too long)
-//   - InfrastructureError: Console write failure or context cancellation
+//   - ValidationError: Invalid person name (empty, too long), unsupported
+//     case, invalid punctuation, or Times outside 1..command.MaxTimes
+//   - InfrastructureError: Console write failure, context cancellation,
+//     or a nil writer ("writer not configured")
 //
 // Contract:
 //   - Pre: ctx is non-nil (use context.Background() if no cancellation needed)
 //   - Pre: cmd can be any GreetCommand (validation happens inside)
 //   - Post: Returns Ok(Unit) if greeting succeeded
-//   - Post: Returns Err(ValidationError) if name validation failed
+//   - Post: The greeting is prefixed "[tenant] " if ctx carries a tenant
+//     (appctx.WithTenant); the Person itself never sees the tenant
+//   - Post: For each valid greeting, reports its length once (WithHistogram)
+//     and logs it if over the warning threshold (WithGreetingLengthWarning)
+//   - Post: On success, raises one event.PersonGreeted (name, clock time)
+//     through the event sink (WithEventSink); failures raise none
+//   - Post: With punctuation other than "!", the greeting comes from the
+//     strategy's GreetWithPunctuation (service.PunctuatedGreetingService);
+//     a strategy without it is a ValidationError (nothing is written)
+//   - Post: Returns Err(ValidationError) if name validation failed, the
+//     punctuation is invalid, or cmd.GetTimes() is outside
+//     1..command.MaxTimes (nothing is written)
 //   - Post: Returns Err(InfrastructureError) if write failed or ctx cancelled
 func (uc *GreetUseCase[W]) Execute(ctx context.Context, cmd command.GreetCommand) domerr.Result[model.Unit] {
-	// Step 1: Validate and create Person from name (domain validation)
-	personResult := valueobject.CreatePerson(cmd.GetName())
+	// Step 1: Validate and create Person from name (domain validation),
+	// then apply the requested letter case (also validated by the domain)
+	// and any inferred honorific
+	personResult := uc.opts.renderPerson(cmd)
 
 	// Step 2-4: Chain operations using railway-oriented programming
 	// AndThenTo enables cross-type chaining: Result[Person] → Result[Unit]
 	// If personResult is Error, error propagates without calling the lambda
 	// If personResult is Ok, lambda executes and may return Ok or Error
-	return domerr.AndThenTo(personResult, func(person valueobject.Person) domerr.Result[model.Unit] {
-		// Application-level greeting format (orchestration, not domain logic)
-		message := formatGreeting(person.GetName())
+	result := domerr.AndThenTo(personResult, func(person valueobject.Person) domerr.Result[model.Unit] {
+		// Greeting strategy is a domain service (default: Person.GreetingMessage,
+		// which is memoized on the Person at creation), unless the command
+		// asks for non-default punctuation (validated by the domain)
+		return domerr.AndThenTo(uc.opts.greeting(person, cmd.GetPunctuation()), func(greeting string) domerr.Result[model.Unit] {
+			message := withTenantPrefix(ctx, greeting)
+			uc.opts.observeGreeting(ctx, message)
+			if !uc.hasWriter {
+				return domerr.Err[model.Unit](domerr.NewInfrastructureError("writer not configured"))
+			}
+
+			// Write to console via output port (STATIC DISPATCH), once per
+			// requested repetition, then raise the domain event on success
+			return writeTimes(ctx, uc.writer, message, cmd.GetTimes()).Inspect(func(model.Unit) {
+				uc.opts.events(ctx, event.NewPersonGreeted(person, uc.opts.clock.Now()))
+			})
+		})
+	})
 
-		// Write to console via output port (STATIC DISPATCH)
-		return uc.writer.Write(ctx, message)
+	uc.opts.metrics.IncrementCounter(outcomeMetric(result), nil)
+	return result.InspectErr(func(e domerr.ErrorType) {
+		uc.opts.log(ctx, "greet failed: "+e.Message)
 	})
 }
 
-// formatGreeting creates the greeting message.
-// This is application-level formatting logic, not domain logic.
-// The format "Hello, <name>!" is an application decision.
-func formatGreeting(name string) string {
-	return "Hello, " + name + "!"
+// withTenantPrefix prepends "[tenant] " to message when ctx carries a
+// tenant. Tenancy is an application concern; the domain greeting stays
+// tenant-agnostic.
+func withTenantPrefix(ctx context.Context, message string) string {
+	if tenant, ok := appctx.TenantFrom(ctx); ok {
+		return "[" + tenant + "] " + message
+	}
+	return message
+}
+
+// writeTimes writes message times times, stopping at the first failure.
+//
+// Contract:
+//   - Post: times < 1 or times > command.MaxTimes returns
+//     Err(ValidationError) without writing
+//   - Post: Returns Ok(Unit) after times successful writes
+//   - Post: Returns the first write error; later writes are not attempted
+func writeTimes[W outbound.WriterPort](ctx context.Context, writer W, message string, times int) domerr.Result[model.Unit] {
+	if times < 1 {
+		return domerr.Err[model.Unit](domerr.NewValidationError(
+			fmt.Sprintf("greeting count must be at least 1, got %d", times)))
+	}
+	if times > command.MaxTimes {
+		return domerr.Err[model.Unit](domerr.NewValidationError(
+			fmt.Sprintf("greeting count must be at most %d, got %d", command.MaxTimes, times)))
+	}
+	for i := 0; i < times; i++ {
+		if result := writer.Write(ctx, message); result.IsError() {
+			return result
+		}
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+// outcomeMetric maps a greet Result to its counter name. It classifies
+// the same way as outcomeTag, but the counter names follow the error
+// kind's Code().
+func outcomeMetric(result domerr.Result[model.Unit]) string {
+	switch outcomeTag(result) {
+	case OutcomeOK:
+		return MetricGreetSuccess
+	case OutcomeValidationError:
+		return MetricGreetValidationError
+	case OutcomeInfraError:
+		return MetricGreetInfrastructureError
+	case OutcomeNotFoundError:
+		return MetricGreetNotFoundError
+	default:
+		return MetricGreetUnknownError
+	}
 }
@@ -0,0 +1,235 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: usecase
+// Description: Batch greeting use case (sequential and worker-pool variants)
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/abitofhelp/hybrid_app_go/application/command"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	"github.com/abitofhelp/hybrid_app_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+// DefaultGreetAllConcurrency is a reasonable worker count for ExecuteConcurrent.
+const DefaultGreetAllConcurrency = 4
+
+// GreetAllUseCase greets a batch of names through a single writer.
+//
+// Both variants run in two phases so output is all-or-nothing and ordered:
+//  1. Render: validate every name and build its greeting (domain logic)
+//  2. Write: if every name was valid, write the greetings in input order
+//
+// ExecuteConcurrent parallelises phase 1 with a bounded worker pool; results
+// are collected into a slice indexed by input position, so the written
+// order is identical to Execute regardless of scheduling.
+//
+// With WithSingleWrite, phase 2 joins the greetings with newlines and
+// writes them in one call; for a line-oriented writer the output is
+// byte-for-byte the same as one write per greeting.
+//
+// Static Dispatch:
+//   - Generic over WriterPort, exactly like GreetUseCase
+type GreetAllUseCase[W outbound.WriterPort] struct {
+	writer W
+	opts   greetOptions
+
+	// render validates one name and returns its greeting.
+	// Replaceable only by in-package tests (to observe worker scheduling).
+	render func(name string) domerr.Result[string]
+}
+
+// NewGreetAllUseCase creates a new GreetAllUseCase with an injected writer.
+//
+// Batches are limited to DefaultMaxBatchSize names unless overridden with
+// WithMaxBatchSize; this guards against huge inputs (e.g., HTTP bodies).
+func NewGreetAllUseCase[W outbound.WriterPort](writer W, opts ...GreetOption) *GreetAllUseCase[W] {
+	options := newGreetOptions(opts)
+	return &GreetAllUseCase[W]{writer: writer, opts: options, render: options.renderGreeting}
+}
+
+// Execute greets every name sequentially.
+//
+// Contract:
+//   - Post: Returns Ok(Unit) if every name was valid and every write succeeded
+//   - Post: Returns Err(ValidationError) without validating or writing
+//     anything if the batch exceeds the maximum batch size
+//   - Post: Returns Err(ValidationError) for the first invalid name (by
+//     position, message prefixed "names[i]: ") and writes nothing
+//   - Post: Returns Err(InfrastructureError) if ctx is cancelled or a write
+//     fails; writing stops at the first failure
+//   - Post: With WithSingleWrite, a non-empty batch is written with exactly
+//     one Write call and an empty batch with none
+func (uc *GreetAllUseCase[W]) Execute(ctx context.Context, cmd command.GreetAllCommand) domerr.Result[model.Unit] {
+	return uc.ExecuteConcurrent(ctx, cmd, 1)
+}
+
+// ExecuteConcurrent greets every name, validating with up to concurrency
+// workers (values below 1 are treated as 1).
+//
+// Contract:
+//   - Same as Execute; output order and the reported validation error are
+//     deterministic (lowest failing index wins) regardless of concurrency
+//   - Post: At most concurrency names are rendered at the same time
+//   - Post: Cancelling ctx stops workers picking up new names
+func (uc *GreetAllUseCase[W]) ExecuteConcurrent(ctx context.Context, cmd command.GreetAllCommand, concurrency int) domerr.Result[model.Unit] {
+	names := cmd.GetNames()
+	if len(names) > uc.opts.maxBatchSize {
+		return domerr.Err[model.Unit](domerr.NewValidationError(fmt.Sprintf(
+			"batch size %d exceeds maximum of %d names", len(names), uc.opts.maxBatchSize)))
+	}
+
+	// Phase 1: render greetings into an indexed slice
+	greetings := uc.renderAll(ctx, names, concurrency)
+	if err := ctx.Err(); err != nil {
+		return cancelled(err)
+	}
+	for i, greeting := range greetings {
+		if greeting.IsError() {
+			err := greeting.ErrorInfo()
+			err.Message = fmt.Sprintf("names[%d]: %s", i, err.Message)
+			return domerr.Err[model.Unit](err)
+		}
+	}
+
+	// Phase 2: write in input order
+	if uc.opts.singleWrite {
+		return uc.writeOnce(ctx, greetings)
+	}
+	for _, greeting := range greetings {
+		if err := ctx.Err(); err != nil {
+			return cancelled(err)
+		}
+		if result := uc.writer.Write(ctx, greeting.Value()); result.IsError() {
+			return result
+		}
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+// writeOnce joins the rendered greetings with newlines and writes them
+// with a single Write call.
+func (uc *GreetAllUseCase[W]) writeOnce(ctx context.Context, greetings []domerr.Result[string]) domerr.Result[model.Unit] {
+	if len(greetings) == 0 {
+		return domerr.Ok(model.UnitValue)
+	}
+	if err := ctx.Err(); err != nil {
+		return cancelled(err)
+	}
+
+	size := len(greetings) - 1
+	for _, greeting := range greetings {
+		size += len(greeting.Value())
+	}
+	var b strings.Builder
+	b.Grow(size)
+	for i, greeting := range greetings {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		b.WriteString(greeting.Value())
+	}
+	return uc.writer.Write(ctx, b.String())
+}
+
+// ExecutePartial greets every valid name and skips invalid ones, for
+// callers that prefer partial success to all-or-nothing.
+//
+// Contract:
+//   - Post: Returns Ok(summary): Greeted counts written greetings, Skipped
+//     counts invalid names, and Errors holds "names[i]: <reason>" for each
+//     skipped name, in input order
+//   - Post: Valid names are written in input order (one call, if
+//     WithSingleWrite)
+//   - Post: Returns Err(ValidationError) without writing if the batch
+//     exceeds the maximum batch size
+//   - Post: Returns Err(InfrastructureError) if ctx is cancelled or a write
+//     fails; earlier greetings stay written
+func (uc *GreetAllUseCase[W]) ExecutePartial(ctx context.Context, cmd command.GreetAllCommand) domerr.Result[model.GreetSummary] {
+	names := cmd.GetNames()
+	if len(names) > uc.opts.maxBatchSize {
+		return domerr.Err[model.GreetSummary](domerr.NewValidationError(fmt.Sprintf(
+			"batch size %d exceeds maximum of %d names", len(names), uc.opts.maxBatchSize)))
+	}
+
+	var summary model.GreetSummary
+	valid := make([]domerr.Result[string], 0, len(names))
+	for i, greeting := range uc.renderAll(ctx, names, 1) {
+		if greeting.IsError() {
+			summary.Skipped++
+			summary.Errors = append(summary.Errors,
+				fmt.Sprintf("names[%d]: %s", i, greeting.ErrorInfo().Message))
+			continue
+		}
+		valid = append(valid, greeting)
+	}
+	if err := ctx.Err(); err != nil {
+		return domerr.Err[model.GreetSummary](cancelled(err).ErrorInfo())
+	}
+
+	if uc.opts.singleWrite {
+		if written := uc.writeOnce(ctx, valid); written.IsError() {
+			return domerr.Err[model.GreetSummary](written.ErrorInfo())
+		}
+		summary.Greeted = len(valid)
+		return domerr.Ok(summary)
+	}
+	for _, greeting := range valid {
+		if written := uc.writer.Write(ctx, greeting.Value()); written.IsError() {
+			return domerr.Err[model.GreetSummary](written.ErrorInfo())
+		}
+		summary.Greeted++
+	}
+	return domerr.Ok(summary)
+}
+
+// renderAll renders names with a pool of concurrency workers.
+// Slots for names not rendered because ctx was cancelled are left zero.
+func (uc *GreetAllUseCase[W]) renderAll(ctx context.Context, names []string, concurrency int) []domerr.Result[string] {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	if concurrency > len(names) {
+		concurrency = len(names)
+	}
+
+	results := make([]domerr.Result[string], len(names))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = uc.render(names[i])
+			}
+		}()
+	}
+
+feed:
+	for i := range names {
+		if ctx.Err() != nil {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			break feed
+		case jobs <- i:
+		}
+	}
+	close(jobs)
+	wg.Wait()
+	return results
+}
+
+// cancelled maps a context error to an InfrastructureError Result.
+func cancelled(err error) domerr.Result[model.Unit] {
+	return domerr.Err[model.Unit](domerr.NewInfrastructureError(fmt.Sprintf("greet cancelled: %v", err)))
+}
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_app_go/application/command"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+)
+
+// countingSink is a WriterPort that counts writes (safe for concurrent use).
+type countingSink struct {
+	mu     sync.Mutex
+	writes int
+}
+
+func (s *countingSink) Write(_ context.Context, _ string) domerr.Result[model.Unit] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.writes++
+	return domerr.Ok(model.UnitValue)
+}
+
+// TestApplicationUseCaseGreetAllWorkers observes the worker pool through
+// the render hook.
+func TestApplicationUseCaseGreetAllWorkers(t *testing.T) {
+	tf := test.New("Application.UseCase.GreetAll.Workers")
+
+	names := make([]string, 40)
+	for i := range names {
+		names[i] = fmt.Sprintf("P%d", i)
+	}
+	cmd := command.NewGreetAllCommand(names)
+
+	// ========================================================================
+	// Test: Concurrency bound is respected
+	// ========================================================================
+
+	const limit = 3
+	var inFlight, peak atomic.Int32
+	uc := NewGreetAllUseCase(&countingSink{})
+	uc.render = func(name string) domerr.Result[string] {
+		n := inFlight.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		inFlight.Add(-1)
+		return uc.opts.renderGreeting(name)
+	}
+	tf.RunTest("Bound - IsOk", uc.ExecuteConcurrent(context.Background(), cmd, limit).IsOk())
+	tf.RunTest("Bound - peak workers <= limit", peak.Load() <= limit)
+	tf.RunTest("Bound - pool actually ran in parallel", peak.Load() > 1)
+
+	// ========================================================================
+	// Test: Cancellation mid-batch stops work and writes nothing
+	// ========================================================================
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var rendered atomic.Int32
+	sink := &countingSink{}
+	uc = NewGreetAllUseCase(sink)
+	uc.render = func(name string) domerr.Result[string] {
+		if rendered.Add(1) == 5 {
+			cancel()
+		}
+		return uc.opts.renderGreeting(name)
+	}
+	result := uc.ExecuteConcurrent(ctx, cmd, 2)
+	tf.AssertError("Cancel - InfrastructureError", result, domerr.InfrastructureError)
+	tf.RunTest("Cancel - remaining names skipped", rendered.Load() < int32(len(names)))
+	tf.AssertEqual("Cancel - nothing written", sink.writes, 0)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
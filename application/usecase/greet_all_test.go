@@ -0,0 +1,260 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_app_go/application/command"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	"github.com/abitofhelp/hybrid_app_go/application/usecase"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/domain/valueobject"
+)
+
+// TestApplicationUseCaseGreetAll tests sequential and concurrent batch greeting.
+func TestApplicationUseCaseGreetAll(t *testing.T) {
+	tf := test.New("Application.UseCase.GreetAll")
+	ctx := context.Background()
+
+	names := make([]string, 50)
+	want := make([]string, len(names))
+	for i := range names {
+		names[i] = fmt.Sprintf("Person%02d", i)
+		want[i] = fmt.Sprintf("Hello, Person%02d!", i)
+	}
+	cmd := command.NewGreetAllCommand(names)
+
+	// ========================================================================
+	// Test: Sequential greets every name in order
+	// ========================================================================
+
+	writer := &recordingWriter{}
+	result := usecase.NewGreetAllUseCase(writer).Execute(ctx, cmd)
+	tf.RunTest("Sequential - IsOk", result.IsOk())
+	tf.AssertEqual("Sequential - input order", writer.messages, want)
+
+	// ========================================================================
+	// Test: Concurrent output order is deterministic
+	// ========================================================================
+
+	for run := 0; run < 10; run++ {
+		writer = &recordingWriter{}
+		result = usecase.NewGreetAllUseCase(writer).ExecuteConcurrent(ctx, cmd, 8)
+		tf.RunTest(fmt.Sprintf("Concurrent run %d - IsOk", run), result.IsOk())
+		tf.AssertEqual(fmt.Sprintf("Concurrent run %d - input order", run), writer.messages, want)
+	}
+
+	// ========================================================================
+	// Test: First validation error (by position) wins; nothing is written
+	// ========================================================================
+
+	bad := command.NewGreetAllCommand([]string{"Alice", "Bob", "", "Carol", strings.Repeat("x", 101)})
+	for _, workers := range []int{1, 4} {
+		writer = &recordingWriter{}
+		result = usecase.NewGreetAllUseCase(writer).ExecuteConcurrent(ctx, bad, workers)
+		label := fmt.Sprintf("Invalid (workers=%d)", workers)
+		tf.AssertError(label+" - ValidationError", result, domerr.ValidationError)
+		if result.IsError() {
+			tf.AssertEqual(label+" - first failing index reported",
+				result.ErrorInfo().Message, "names[2]: "+valueobject.ErrMsgEmptyName)
+		}
+		tf.AssertEqual(label+" - nothing written", len(writer.messages), 0)
+	}
+
+	// ========================================================================
+	// Test: Cancelled context writes nothing
+	// ========================================================================
+
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	writer = &recordingWriter{}
+	result = usecase.NewGreetAllUseCase(writer).ExecuteConcurrent(cancelledCtx, cmd, 4)
+	tf.AssertError("Cancelled - InfrastructureError", result, domerr.InfrastructureError)
+	tf.AssertEqual("Cancelled - nothing written", len(writer.messages), 0)
+
+	// ========================================================================
+	// Test: Empty batch and non-positive concurrency
+	// ========================================================================
+
+	writer = &recordingWriter{}
+	tf.RunTest("Empty batch - IsOk",
+		usecase.NewGreetAllUseCase(writer).ExecuteConcurrent(ctx, command.NewGreetAllCommand(nil), 4).IsOk())
+	tf.RunTest("Zero concurrency - treated as 1",
+		usecase.NewGreetAllUseCase(writer).ExecuteConcurrent(ctx, command.NewGreetAllCommand([]string{"Al"}), 0).IsOk())
+	tf.AssertEqual("Zero concurrency - written", writer.messages, []string{"Hello, Al!"})
+
+	// ========================================================================
+	// Test: Maximum batch size guard
+	// ========================================================================
+
+	limited := func(n int) command.GreetAllCommand {
+		return command.NewGreetAllCommand(names[:n])
+	}
+	writer = &recordingWriter{}
+	result = usecase.NewGreetAllUseCase(writer, usecase.WithMaxBatchSize(10)).Execute(ctx, limited(10))
+	tf.RunTest("Max batch - exactly max accepted", result.IsOk())
+	tf.AssertEqual("Max batch - all written", len(writer.messages), 10)
+
+	writer = &recordingWriter{}
+	result = usecase.NewGreetAllUseCase(writer, usecase.WithMaxBatchSize(10)).ExecuteConcurrent(ctx, limited(11), 4)
+	tf.AssertError("Max batch - max+1 rejected", result, domerr.ValidationError)
+	if result.IsError() {
+		tf.AssertEqual("Max batch - message",
+			result.ErrorInfo().Message, "batch size 11 exceeds maximum of 10 names")
+	}
+	tf.AssertEqual("Max batch - nothing written", len(writer.messages), 0)
+
+	big := command.NewGreetAllCommand(make([]string, usecase.DefaultMaxBatchSize+1))
+	writer = &recordingWriter{}
+	result = usecase.NewGreetAllUseCase(writer).Execute(ctx, big)
+	tf.AssertError("Default max - rejected", result, domerr.ValidationError)
+	tf.AssertEqual("Default max - nothing written", len(writer.messages), 0)
+
+	// ========================================================================
+	// Test: Single-write mode matches per-line output
+	// ========================================================================
+
+	perLine := &recordingWriter{}
+	usecase.NewGreetAllUseCase(perLine).Execute(ctx, cmd)
+	var concatenated strings.Builder
+	for _, message := range perLine.messages {
+		concatenated.WriteString(message + "\n")
+	}
+
+	for _, workers := range []int{1, 4} {
+		writer = &recordingWriter{}
+		result = usecase.NewGreetAllUseCase(writer, usecase.WithSingleWrite()).ExecuteConcurrent(ctx, cmd, workers)
+		label := fmt.Sprintf("Single write (workers=%d)", workers)
+		tf.RunTest(label+" - IsOk", result.IsOk())
+		tf.AssertEqual(label+" - one Write call", len(writer.messages), 1)
+		if len(writer.messages) == 1 {
+			tf.AssertEqual(label+" - equals concatenated lines",
+				writer.messages[0]+"\n", concatenated.String())
+		}
+	}
+
+	writer = &recordingWriter{}
+	tf.RunTest("Single write, empty batch - IsOk",
+		usecase.NewGreetAllUseCase(writer, usecase.WithSingleWrite()).Execute(ctx, command.NewGreetAllCommand(nil)).IsOk())
+	tf.AssertEqual("Single write, empty batch - no Write call", len(writer.messages), 0)
+
+	writer = &recordingWriter{}
+	result = usecase.NewGreetAllUseCase(writer, usecase.WithSingleWrite()).Execute(ctx, bad)
+	tf.AssertError("Single write, invalid - ValidationError", result, domerr.ValidationError)
+	tf.AssertEqual("Single write, invalid - nothing written", len(writer.messages), 0)
+
+	result = usecase.NewGreetAllUseCase(&failOnCallWriter{failOn: 1}, usecase.WithSingleWrite()).Execute(ctx, cmd)
+	tf.AssertError("Single write, write fails - InfrastructureError", result, domerr.InfrastructureError)
+
+	// ========================================================================
+	// Test: ExecutePartial greets valid names and summarizes skipped ones
+	// ========================================================================
+
+	writer = &recordingWriter{}
+	partial := usecase.NewGreetAllUseCase(writer).ExecutePartial(ctx, bad)
+	tf.RunTest("Partial, mixed - IsOk", partial.IsOk())
+	tf.AssertEqual("Partial, mixed - summary", partial.Value(), model.GreetSummary{
+		Greeted: 3,
+		Skipped: 2,
+		Errors: []string{
+			"names[2]: " + valueobject.ErrMsgEmptyName,
+			"names[4]: " + fmt.Sprintf(valueobject.ErrMsgNameTooLong, valueobject.MaxNameLength),
+		},
+	})
+	tf.AssertEqual("Partial, mixed - valid names written in order",
+		writer.messages, []string{"Hello, Alice!", "Hello, Bob!", "Hello, Carol!"})
+
+	writer = &recordingWriter{}
+	partial = usecase.NewGreetAllUseCase(writer, usecase.WithSingleWrite()).ExecutePartial(ctx, bad)
+	tf.AssertEqual("Partial, single write - counts", partial.Value().Greeted, 3)
+	tf.AssertEqual("Partial, single write - one Write call",
+		writer.messages, []string{"Hello, Alice!\nHello, Bob!\nHello, Carol!"})
+
+	writer = &recordingWriter{}
+	partial = usecase.NewGreetAllUseCase(writer).ExecutePartial(ctx, command.NewGreetAllCommand([]string{"", ""}))
+	tf.AssertEqual("Partial, all invalid - summary counts",
+		[]int{partial.Value().Greeted, partial.Value().Skipped}, []int{0, 2})
+	tf.AssertEqual("Partial, all invalid - nothing written", len(writer.messages), 0)
+
+	writer = &recordingWriter{}
+	partial = usecase.NewGreetAllUseCase(writer, usecase.WithMaxBatchSize(2)).ExecutePartial(ctx, bad)
+	tf.AssertError("Partial, too large - ValidationError", partial, domerr.ValidationError)
+	tf.AssertEqual("Partial, too large - nothing written", len(writer.messages), 0)
+
+	failing := &failOnCallWriter{failOn: 2}
+	partial = usecase.NewGreetAllUseCase(failing).ExecutePartial(ctx, cmd)
+	tf.AssertError("Partial, write fails - InfrastructureError", partial, domerr.InfrastructureError)
+	tf.AssertEqual("Partial, write fails - stops at failing call", failing.calls, 2)
+
+	partial = usecase.NewGreetAllUseCase(&recordingWriter{}).ExecutePartial(cancelledCtx, cmd)
+	tf.AssertError("Partial, cancelled - InfrastructureError", partial, domerr.InfrastructureError)
+
+	// ========================================================================
+	// Test: Inferred honorifics apply to each name in the batch
+	// ========================================================================
+
+	writer = &recordingWriter{}
+	staff := honorificTable{"Bob": "Prof."}
+	partial = usecase.NewGreetAllUseCase(writer, usecase.WithHonorific(staff.infer)).ExecutePartial(ctx, bad)
+	tf.RunTest("Honorific - IsOk", partial.IsOk())
+	tf.AssertEqual("Honorific - applied per name", writer.messages, []string{"Hello, Alice!", "Hello, Prof. Bob!", "Hello, Carol!"})
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
+
+// lineWriter is a WriterPort double that behaves like ConsoleWriter:
+// one newline-terminated write to the underlying io.Writer per call.
+type lineWriter struct {
+	out io.Writer
+}
+
+func (w lineWriter) Write(_ context.Context, message string) domerr.Result[model.Unit] {
+	if _, err := fmt.Fprintln(w.out, message); err != nil {
+		return domerr.Err[model.Unit](domerr.NewInfrastructureError(err.Error()))
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+// benchmarkGreetAll greets a 500-name batch into the null device, so each
+// Write call costs a real syscall, as it would on a console.
+func benchmarkGreetAll(b *testing.B, opts ...usecase.GreetOption) {
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		b.Skipf("cannot open %s: %v", os.DevNull, err)
+	}
+	defer devNull.Close()
+
+	names := make([]string, 500)
+	for i := range names {
+		names[i] = fmt.Sprintf("Person%03d", i)
+	}
+	cmd := command.NewGreetAllCommand(names)
+	uc := usecase.NewGreetAllUseCase(lineWriter{out: devNull}, opts...)
+	ctx := context.Background()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = uc.Execute(ctx, cmd)
+	}
+}
+
+// BenchmarkGreetAllPerLine measures the default mode: one Write per greeting.
+func BenchmarkGreetAllPerLine(b *testing.B) {
+	benchmarkGreetAll(b)
+}
+
+// BenchmarkGreetAllSingleWrite measures WithSingleWrite: one buffered Write
+// per batch.
+func BenchmarkGreetAllSingleWrite(b *testing.B) {
+	benchmarkGreetAll(b, usecase.WithSingleWrite())
+}
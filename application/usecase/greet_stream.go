@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: usecase
+// Description: Streaming greet use case (reads names until EOF)
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	"github.com/abitofhelp/hybrid_app_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/valueobject"
+)
+
+// GreetStreamUseCase greets each name read from a ReaderPort until EOF.
+//
+// Unlike GreetAllUseCase (all-or-nothing), this use case is lenient about
+// input: invalid names are skipped and recorded in the summary, so one bad
+// line does not lose the rest of the stream. Infrastructure failures
+// (read or write) still stop the run.
+//
+// Static Dispatch:
+//   - Generic over both the reader R and the writer W
+type GreetStreamUseCase[R outbound.ReaderPort, W outbound.WriterPort] struct {
+	reader R
+	writer W
+}
+
+// NewGreetStreamUseCase creates a new GreetStreamUseCase with injected ports.
+func NewGreetStreamUseCase[R outbound.ReaderPort, W outbound.WriterPort](reader R, writer W) *GreetStreamUseCase[R, W] {
+	return &GreetStreamUseCase[R, W]{reader: reader, writer: writer}
+}
+
+// Execute reads names line by line and greets each valid one.
+//
+// Contract:
+//   - Post: Returns Ok(summary) once the reader reports EOF; Greeted counts
+//     written greetings, Skipped counts invalid names, and Errors holds
+//     "line N: <reason>" (1-based) for each skipped name
+//   - Post: Returns Err(InfrastructureError) on the first read or write
+//     failure (including ctx cancellation); earlier greetings stay written
+func (uc *GreetStreamUseCase[R, W]) Execute(ctx context.Context) domerr.Result[model.GreetSummary] {
+	return uc.run(ctx, false)
+}
+
+// ExecuteInteractive is Execute for a user typing names: a blank (or
+// whitespace-only) line also ends the run, and ctx is checked before each
+// read so a cancelled session stops without waiting for more input.
+//
+// Implements: inbound.GreetInteractivePort
+//
+// Contract:
+//   - Post: Returns Ok(summary) at EOF or on the first blank line; lines
+//     after the blank line are not read
+//   - Post: Returns Err(InfrastructureError) if ctx is cancelled before a
+//     read, or on the first read or write failure
+func (uc *GreetStreamUseCase[R, W]) ExecuteInteractive(ctx context.Context) domerr.Result[model.GreetSummary] {
+	return uc.run(ctx, true)
+}
+
+// run reads and greets names until EOF or, if stopOnBlank, a blank line.
+func (uc *GreetStreamUseCase[R, W]) run(ctx context.Context, stopOnBlank bool) domerr.Result[model.GreetSummary] {
+	var summary model.GreetSummary
+
+	for lineNo := 1; ; lineNo++ {
+		if stopOnBlank && ctx.Err() != nil {
+			return domerr.Err[model.GreetSummary](domerr.NewInfrastructureError(
+				fmt.Sprintf("interactive session cancelled: %v", ctx.Err())))
+		}
+		read := uc.reader.ReadLine(ctx)
+		if read.IsError() {
+			return domerr.Err[model.GreetSummary](read.ErrorInfo())
+		}
+		line := read.Value()
+		if line.IsNone() || (stopOnBlank && strings.TrimSpace(line.Value()) == "") {
+			return domerr.Ok(summary)
+		}
+
+		person := valueobject.CreatePerson(line.Value())
+		if person.IsError() {
+			summary.Skipped++
+			summary.Errors = append(summary.Errors,
+				fmt.Sprintf("line %d: %s", lineNo, person.ErrorInfo().Message))
+			continue
+		}
+
+		if written := uc.writer.Write(ctx, person.Value().GreetingMessage()); written.IsError() {
+			return domerr.Err[model.GreetSummary](written.ErrorInfo())
+		}
+		summary.Greeted++
+	}
+}
@@ -0,0 +1,164 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package usecase_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	"github.com/abitofhelp/hybrid_app_go/application/port/inbound"
+	"github.com/abitofhelp/hybrid_app_go/application/usecase"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/domain/valueobject"
+)
+
+// sliceReader is an in-memory ReaderPort test double.
+// If failAt > 0, the failAt-th call returns an InfrastructureError.
+type sliceReader struct {
+	lines  []string
+	calls  int
+	failAt int
+}
+
+func (r *sliceReader) ReadLine(_ context.Context) domerr.Result[valueobject.Option[string]] {
+	r.calls++
+	if r.failAt > 0 && r.calls == r.failAt {
+		return domerr.Err[valueobject.Option[string]](domerr.NewInfrastructureError("read failed"))
+	}
+	if len(r.lines) == 0 {
+		return domerr.Ok(valueobject.None[string]())
+	}
+	line := r.lines[0]
+	r.lines = r.lines[1:]
+	return domerr.Ok(valueobject.Some(line))
+}
+
+// TestApplicationUseCaseGreetStream tests reading names until EOF.
+func TestApplicationUseCaseGreetStream(t *testing.T) {
+	tf := test.New("Application.UseCase.GreetStream")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Mixed valid and invalid lines
+	// ========================================================================
+
+	reader := &sliceReader{lines: []string{"Alice", "", "Bob", strings.Repeat("x", 101), "Carol"}}
+	writer := &recordingWriter{}
+	result := usecase.NewGreetStreamUseCase(reader, writer).Execute(ctx)
+	tf.RunTest("Mixed - IsOk", result.IsOk())
+	tf.AssertEqual("Mixed - summary", result.Value(), model.GreetSummary{
+		Greeted: 3,
+		Skipped: 2,
+		Errors: []string{
+			"line 2: " + valueobject.ErrMsgEmptyName,
+			"line 4: " + fmt.Sprintf(valueobject.ErrMsgNameTooLong, valueobject.MaxNameLength),
+		},
+	})
+	tf.AssertEqual("Mixed - valid names greeted in order",
+		writer.messages, []string{"Hello, Alice!", "Hello, Bob!", "Hello, Carol!"})
+
+	// ========================================================================
+	// Test: Empty stream
+	// ========================================================================
+
+	writer = &recordingWriter{}
+	result = usecase.NewGreetStreamUseCase(&sliceReader{}, writer).Execute(ctx)
+	tf.RunTest("Empty - IsOk", result.IsOk())
+	tf.AssertEqual("Empty - zero summary", result.Value(), model.GreetSummary{})
+	tf.AssertEqual("Empty - nothing written", len(writer.messages), 0)
+
+	// ========================================================================
+	// Test: Read failure stops the stream
+	// ========================================================================
+
+	writer = &recordingWriter{}
+	reader = &sliceReader{lines: []string{"Alice", "Bob"}, failAt: 2}
+	result = usecase.NewGreetStreamUseCase(reader, writer).Execute(ctx)
+	tf.AssertError("Read failure - InfrastructureError", result, domerr.InfrastructureError)
+	tf.AssertEqual("Read failure - earlier greeting kept", writer.messages, []string{"Hello, Alice!"})
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
+
+// Compile-time check: GreetStreamUseCase satisfies the inbound GreetInteractivePort contract.
+var _ inbound.GreetInteractivePort = (*usecase.GreetStreamUseCase[*sliceReader, *recordingWriter])(nil)
+
+// cancellingReader is a ReaderPort double that cancels its context after
+// returning its lines, then keeps returning more names.
+type cancellingReader struct {
+	lines  []string
+	cancel context.CancelFunc
+	calls  int
+}
+
+func (r *cancellingReader) ReadLine(_ context.Context) domerr.Result[valueobject.Option[string]] {
+	r.calls++
+	if r.calls >= len(r.lines) {
+		r.cancel()
+	}
+	return domerr.Ok(valueobject.Some(r.lines[min(r.calls, len(r.lines))-1]))
+}
+
+// TestApplicationUseCaseGreetInteractive tests the interactive loop.
+func TestApplicationUseCaseGreetInteractive(t *testing.T) {
+	tf := test.New("Application.UseCase.GreetInteractive")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Several names then EOF
+	// ========================================================================
+
+	writer := &recordingWriter{}
+	reader := &sliceReader{lines: []string{"Alice", "Bob", "Carol"}}
+	result := usecase.NewGreetStreamUseCase(reader, writer).ExecuteInteractive(ctx)
+	tf.RunTest("EOF - IsOk", result.IsOk())
+	tf.AssertEqual("EOF - summary", result.Value(), model.GreetSummary{Greeted: 3})
+	tf.AssertEqual("EOF - greetings in order",
+		writer.messages, []string{"Hello, Alice!", "Hello, Bob!", "Hello, Carol!"})
+
+	// ========================================================================
+	// Test: Blank line terminates the loop; later lines are not read
+	// ========================================================================
+
+	writer = &recordingWriter{}
+	reader = &sliceReader{lines: []string{"Alice", "Bob", "  ", "Carol"}}
+	result = usecase.NewGreetStreamUseCase(reader, writer).ExecuteInteractive(ctx)
+	tf.RunTest("Blank - IsOk", result.IsOk())
+	tf.AssertEqual("Blank - greetings before blank", writer.messages, []string{"Hello, Alice!", "Hello, Bob!"})
+	tf.AssertEqual("Blank - stopped reading", reader.calls, 3)
+	tf.AssertEqual("Blank - line after blank left unread", reader.lines, []string{"Carol"})
+
+	// ========================================================================
+	// Test: Invalid names are skipped, as in Execute
+	// ========================================================================
+
+	writer = &recordingWriter{}
+	reader = &sliceReader{lines: []string{"Alice", strings.Repeat("x", 101), "Bob"}}
+	result = usecase.NewGreetStreamUseCase(reader, writer).ExecuteInteractive(ctx)
+	tf.AssertEqual("Invalid - skipped and reported", result.Value(), model.GreetSummary{
+		Greeted: 2,
+		Skipped: 1,
+		Errors:  []string{"line 2: " + fmt.Sprintf(valueobject.ErrMsgNameTooLong, valueobject.MaxNameLength)},
+	})
+
+	// ========================================================================
+	// Test: Cancellation is honored between reads
+	// ========================================================================
+
+	cctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	writer = &recordingWriter{}
+	cancelling := &cancellingReader{lines: []string{"Alice", "Bob"}, cancel: cancel}
+	result = usecase.NewGreetStreamUseCase(cancelling, writer).ExecuteInteractive(cctx)
+	tf.AssertError("Cancelled - InfrastructureError", result, domerr.InfrastructureError)
+	tf.AssertEqual("Cancelled - no read after cancel", cancelling.calls, 2)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
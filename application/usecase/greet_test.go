@@ -0,0 +1,518 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package usecase_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_app_go/application/command"
+	appctx "github.com/abitofhelp/hybrid_app_go/application/context"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	"github.com/abitofhelp/hybrid_app_go/application/port/inbound"
+	"github.com/abitofhelp/hybrid_app_go/application/port/outbound"
+	"github.com/abitofhelp/hybrid_app_go/application/usecase"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/event"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/domain/valueobject"
+)
+
+// recordingWriter is a WriterPort test double that records every message.
+type recordingWriter struct {
+	messages []string
+}
+
+func (w *recordingWriter) Write(_ context.Context, message string) domerr.Result[model.Unit] {
+	w.messages = append(w.messages, message)
+	return domerr.Ok(model.UnitValue)
+}
+
+// failOnCallWriter is a WriterPort test double whose failOn-th call fails.
+type failOnCallWriter struct {
+	failOn int
+	calls  int
+}
+
+func (w *failOnCallWriter) Write(_ context.Context, _ string) domerr.Result[model.Unit] {
+	w.calls++
+	if w.calls == w.failOn {
+		return domerr.Err[model.Unit](domerr.NewInfrastructureError("sink unavailable"))
+	}
+	return domerr.Ok(model.UnitValue)
+}
+
+// writerFunc is a function-typed WriterPort double, so tests can inject a
+// nil func the way bootstrap could inject a nil adapter.WriterFunc.
+type writerFunc func(ctx context.Context, message string) domerr.Result[model.Unit]
+
+func (f writerFunc) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	return f(ctx, message)
+}
+
+// formalGreeting is a custom GreetingService strategy.
+type formalGreeting struct{}
+
+func (formalGreeting) Greet(p valueobject.Person) string {
+	return "Good day, " + p.GetName() + "."
+}
+
+// punctuatedFormalGreeting is a custom PunctuatedGreetingService strategy.
+type punctuatedFormalGreeting struct{ formalGreeting }
+
+func (punctuatedFormalGreeting) GreetWithPunctuation(p valueobject.Person, punctuation string) domerr.Result[string] {
+	return domerr.Ok("Good day, " + p.GetName() + punctuation)
+}
+
+// nameRecordingGreeting is a GreetingService double that records the
+// name of every Person it greets.
+type nameRecordingGreeting struct {
+	names []string
+}
+
+func (g *nameRecordingGreeting) Greet(p valueobject.Person) string {
+	g.names = append(g.names, p.GetName())
+	return p.GreetingMessage()
+}
+
+// honorificTable is a stub HonorificFunc backed by a lookup table.
+type honorificTable map[string]string
+
+func (t honorificTable) infer(name string) (string, bool) {
+	honorific, ok := t[name]
+	return honorific, ok
+}
+
+// fixedClock is a ClockPort test double that always returns the same instant.
+type fixedClock struct {
+	at time.Time
+}
+
+func (c fixedClock) Now() time.Time {
+	return c.at
+}
+
+// observation is one value recorded through a HistogramPort.
+type observation struct {
+	name  string
+	value float64
+}
+
+// histogramRecorder is a fake HistogramPort that records every value.
+type histogramRecorder struct {
+	observations []observation
+}
+
+func (h *histogramRecorder) RecordValue(name string, value float64, _ map[string]string) {
+	h.observations = append(h.observations, observation{name: name, value: value})
+}
+
+// logRecorder is a fake LoggerPort that records every entry and message.
+type logRecorder struct {
+	entries  []outbound.LogEntry
+	messages []string
+}
+
+func (l *logRecorder) Log(entry outbound.LogEntry) {
+	l.entries = append(l.entries, entry)
+	l.messages = append(l.messages, entry.Message)
+}
+
+// panics reports whether f panics.
+func panics(f func()) (panicked bool) {
+	defer func() { panicked = recover() != nil }()
+	f()
+	return false
+}
+
+// counterRecorder is a fake MetricsPort that records counter names.
+type counterRecorder struct {
+	names []string
+}
+
+func (c *counterRecorder) IncrementCounter(name string, _ map[string]string) {
+	c.names = append(c.names, name)
+}
+
+// eventRecorder is an in-memory event sink.
+type eventRecorder struct {
+	events []event.PersonGreeted
+}
+
+func (r *eventRecorder) sink(_ context.Context, e event.PersonGreeted) {
+	r.events = append(r.events, e)
+}
+
+// Compile-time check: GreetUseCase satisfies the inbound GreetPort contract,
+// so any driving adapter (CLI, HTTP) can depend on the port alone.
+var _ inbound.GreetPort = (*usecase.GreetUseCase[*recordingWriter])(nil)
+
+// TestApplicationUseCaseGreet tests GreetUseCase through the GreetPort contract.
+func TestApplicationUseCaseGreet(t *testing.T) {
+	tf := test.New("Application.UseCase.Greet")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Valid name writes the greeting
+	// ========================================================================
+
+	writer := &recordingWriter{}
+	var port inbound.GreetPort = usecase.NewGreetUseCase(writer)
+	result := port.Execute(ctx, command.NewGreetCommand("Alice"))
+	tf.RunTest("Valid name - IsOk", result.IsOk())
+	tf.AssertEqual("Valid name - greeting written", writer.messages, []string{"Hello, Alice!"})
+
+	// ========================================================================
+	// Test: Invalid name never reaches the writer
+	// ========================================================================
+
+	writer = &recordingWriter{}
+	port = usecase.NewGreetUseCase(writer)
+	result = port.Execute(ctx, command.NewGreetCommand(""))
+	tf.AssertError("Empty name - ValidationError", result, domerr.ValidationError)
+	tf.AssertEqual("Empty name - writer not called", len(writer.messages), 0)
+
+	// ========================================================================
+	// Test: Custom greeting strategy is written
+	// ========================================================================
+
+	writer = &recordingWriter{}
+	port = usecase.NewGreetUseCase(writer, usecase.WithGreetingService(formalGreeting{}))
+	result = port.Execute(ctx, command.NewGreetCommand("Alice"))
+	tf.RunTest("Custom strategy - IsOk", result.IsOk())
+	tf.AssertEqual("Custom strategy - strategy output written",
+		writer.messages, []string{"Good day, Alice."})
+
+	// ========================================================================
+	// Test: Repeat count
+	// ========================================================================
+
+	writer = &recordingWriter{}
+	result = usecase.NewGreetUseCase(writer).Execute(ctx, command.NewGreetCommand("Alice").WithTimes(1))
+	tf.RunTest("Times 1 - IsOk", result.IsOk())
+	tf.AssertEqual("Times 1 - one write", writer.messages, []string{"Hello, Alice!"})
+
+	writer = &recordingWriter{}
+	result = usecase.NewGreetUseCase(writer).Execute(ctx, command.NewGreetCommand("Alice").WithTimes(3))
+	tf.RunTest("Times 3 - IsOk", result.IsOk())
+	tf.AssertEqual("Times 3 - three writes", writer.messages,
+		[]string{"Hello, Alice!", "Hello, Alice!", "Hello, Alice!"})
+
+	zero := &failOnCallWriter{}
+	result = usecase.NewGreetUseCase(zero).Execute(ctx, command.NewGreetCommand("Alice").WithTimes(0))
+	tf.AssertError("Times 0 - ValidationError", result, domerr.ValidationError)
+	if result.IsError() {
+		tf.AssertEqual("Times 0 - message",
+			result.ErrorInfo().Message, "greeting count must be at least 1, got 0")
+	}
+	tf.AssertEqual("Times 0 - writer never called", zero.calls, 0)
+
+	zero = &failOnCallWriter{}
+	result = usecase.NewGreetUseCase(zero).Execute(ctx, command.GreetCommand{Name: "Alice"})
+	tf.AssertError("Zero-value Times - ValidationError", result, domerr.ValidationError)
+	tf.AssertEqual("Zero-value Times - writer never called", zero.calls, 0)
+
+	writer = &recordingWriter{}
+	result = usecase.NewGreetUseCase(writer).Execute(ctx, command.NewGreetCommand("Alice").WithTimes(-1))
+	tf.AssertError("Times -1 - ValidationError", result, domerr.ValidationError)
+	if result.IsError() {
+		tf.AssertEqual("Times -1 - message",
+			result.ErrorInfo().Message, "greeting count must be at least 1, got -1")
+	}
+	tf.AssertEqual("Times -1 - nothing written", len(writer.messages), 0)
+
+	writer = &recordingWriter{}
+	result = usecase.NewGreetUseCase(writer).Execute(ctx, command.NewGreetCommand("Alice").WithTimes(command.MaxTimes))
+	tf.AssertEqual("Times MaxTimes - all written", len(writer.messages), command.MaxTimes)
+
+	writer = &recordingWriter{}
+	result = usecase.NewGreetUseCase(writer).Execute(ctx, command.NewGreetCommand("Alice").WithTimes(command.MaxTimes+1))
+	tf.AssertError("Times above MaxTimes - ValidationError", result, domerr.ValidationError)
+	if result.IsError() {
+		tf.AssertEqual("Times above MaxTimes - message",
+			result.ErrorInfo().Message, "greeting count must be at most 100, got 101")
+	}
+	tf.AssertEqual("Times above MaxTimes - nothing written", len(writer.messages), 0)
+
+	failing := &failOnCallWriter{failOn: 2}
+	result = usecase.NewGreetUseCase(failing).Execute(ctx, command.NewGreetCommand("Alice").WithTimes(3))
+	tf.AssertError("Failure on 2nd write - InfrastructureError", result, domerr.InfrastructureError)
+	tf.AssertEqual("Failure on 2nd write - stops after failing call", failing.calls, 2)
+
+	// ========================================================================
+	// Test: Letter case
+	// ========================================================================
+
+	writer = &recordingWriter{}
+	result = usecase.NewGreetUseCase(writer).Execute(ctx, command.NewGreetCommand("bob smith").WithCase("title"))
+	tf.RunTest("Case title - IsOk", result.IsOk())
+	tf.AssertEqual("Case title - transformed greeting", writer.messages, []string{"Hello, Bob Smith!"})
+
+	writer = &recordingWriter{}
+	result = usecase.NewGreetUseCase(writer).Execute(ctx, command.NewGreetCommand("Alice").WithCase("shouty"))
+	tf.AssertError("Case unsupported - ValidationError", result, domerr.ValidationError)
+	tf.AssertEqual("Case unsupported - nothing written", len(writer.messages), 0)
+
+	// ========================================================================
+	// Test: Punctuation
+	// ========================================================================
+
+	writer = &recordingWriter{}
+	usecase.NewGreetUseCase(writer).Execute(ctx, command.NewGreetCommand("Alice").WithPunctuation("."))
+	usecase.NewGreetUseCase(writer).Execute(ctx, command.NewGreetCommand("Bob").WithPunctuation(""))
+	tf.AssertEqual("Punctuation '.' and empty - applied", writer.messages,
+		[]string{"Hello, Alice.", "Hello, Bob"})
+
+	writer = &recordingWriter{}
+	usecase.NewGreetUseCase(writer, usecase.WithGreetingService(formalGreeting{})).
+		Execute(ctx, command.NewGreetCommand("Alice"))
+	tf.AssertEqual("Punctuation default - strategy kept", writer.messages, []string{"Good day, Alice."})
+
+	writer = &recordingWriter{}
+	result = usecase.NewGreetUseCase(writer, usecase.WithGreetingService(formalGreeting{})).
+		Execute(ctx, command.NewGreetCommand("Alice").WithPunctuation("."))
+	tf.AssertError("Punctuation with plain strategy - ValidationError", result, domerr.ValidationError)
+	if result.IsError() {
+		tf.AssertEqual("Punctuation with plain strategy - message", result.ErrorInfo().Message,
+			`the configured greeting strategy does not support punctuation "."`)
+	}
+	tf.AssertEqual("Punctuation with plain strategy - nothing written", len(writer.messages), 0)
+
+	writer = &recordingWriter{}
+	usecase.NewGreetUseCase(writer, usecase.WithGreetingService(punctuatedFormalGreeting{})).
+		Execute(ctx, command.NewGreetCommand("Alice").WithPunctuation("?"))
+	tf.AssertEqual("Punctuation with punctuated strategy - strategy applies it", writer.messages,
+		[]string{"Good day, Alice?"})
+
+	writer = &recordingWriter{}
+	result = usecase.NewGreetUseCase(writer).Execute(ctx, command.NewGreetCommand("Alice").WithPunctuation("!!!"))
+	tf.AssertError("Punctuation too long - ValidationError", result, domerr.ValidationError)
+	tf.AssertEqual("Punctuation too long - nothing written", len(writer.messages), 0)
+
+	// ========================================================================
+	// Test: Tenant prefix from context
+	// ========================================================================
+
+	writer = &recordingWriter{}
+	names := &nameRecordingGreeting{}
+	tenantCtx := appctx.WithTenant(ctx, "acme")
+	result = usecase.NewGreetUseCase(writer, usecase.WithGreetingService(names)).
+		Execute(tenantCtx, command.NewGreetCommand("Alice"))
+	tf.RunTest("Tenant present - IsOk", result.IsOk())
+	tf.AssertEqual("Tenant present - prefix applied", writer.messages, []string{"[acme] Hello, Alice!"})
+	tf.AssertEqual("Tenant present - Person name unaffected", names.names, []string{"Alice"})
+
+	writer = &recordingWriter{}
+	usecase.NewGreetUseCase(writer).Execute(ctx, command.NewGreetCommand("Alice"))
+	tf.AssertEqual("Tenant absent - no prefix", writer.messages, []string{"Hello, Alice!"})
+
+	writer = &recordingWriter{}
+	usecase.NewGreetUseCase(writer).Execute(appctx.WithTenant(ctx, ""), command.NewGreetCommand("Alice"))
+	tf.AssertEqual("Tenant empty - no prefix", writer.messages, []string{"Hello, Alice!"})
+
+	// ========================================================================
+	// Test: Inferred honorific becomes the title; the name is unchanged
+	// ========================================================================
+
+	staff := honorificTable{"Alice": "Dr.", "BOB": "Prof.", "Eve": "Supreme Chancellor Emeritus"}
+
+	writer = &recordingWriter{}
+	names = &nameRecordingGreeting{}
+	result = usecase.NewGreetUseCase(writer, usecase.WithHonorific(staff.infer), usecase.WithGreetingService(names)).
+		Execute(ctx, command.NewGreetCommand("Alice"))
+	tf.RunTest("Honorific inferred - IsOk", result.IsOk())
+	tf.AssertEqual("Honorific inferred - prepended", writer.messages, []string{"Hello, Dr. Alice!"})
+	tf.AssertEqual("Honorific inferred - GetName unchanged", names.names, []string{"Alice"})
+
+	writer = &recordingWriter{}
+	usecase.NewGreetUseCase(writer, usecase.WithHonorific(staff.infer)).Execute(ctx, command.NewGreetCommand("Carol"))
+	tf.AssertEqual("Honorific not inferred - plain greeting", writer.messages, []string{"Hello, Carol!"})
+
+	writer = &recordingWriter{}
+	usecase.NewGreetUseCase(writer, usecase.WithHonorific(staff.infer)).
+		Execute(ctx, command.NewGreetCommand("bob").WithCase("upper"))
+	tf.AssertEqual("Honorific - consulted after case", writer.messages, []string{"Hello, Prof. BOB!"})
+
+	writer = &recordingWriter{}
+	result = usecase.NewGreetUseCase(writer, usecase.WithHonorific(staff.infer)).
+		Execute(ctx, command.NewGreetCommand("Eve"))
+	tf.AssertError("Honorific too long - ValidationError", result, domerr.ValidationError)
+	tf.AssertEqual("Honorific too long - nothing written", len(writer.messages), 0)
+
+	// ========================================================================
+	// Test: PersonGreeted event fires only on success
+	// ========================================================================
+
+	at := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	events := &eventRecorder{}
+	withEvents := func(w outbound.WriterPort) *usecase.GreetUseCase[outbound.WriterPort] {
+		return usecase.NewGreetUseCase(w, usecase.WithEventSink(events.sink), usecase.WithClock(fixedClock{at: at}))
+	}
+
+	result = withEvents(&recordingWriter{}).Execute(ctx, command.NewGreetCommand("Alice").WithCase("upper"))
+	tf.RunTest("Event on success - IsOk", result.IsOk())
+	tf.AssertEqual("Event on success - one event with name and clock time",
+		events.events, []event.PersonGreeted{{Name: "ALICE", At: at}})
+
+	events.events = nil
+	withEvents(&recordingWriter{}).Execute(ctx, command.NewGreetCommand("Bob").WithTimes(3))
+	tf.AssertEqual("Event with count - raised once per greet", len(events.events), 1)
+
+	events.events = nil
+	withEvents(&recordingWriter{}).Execute(ctx, command.NewGreetCommand(""))
+	tf.AssertEqual("No event on validation error", len(events.events), 0)
+
+	events.events = nil
+	withEvents(&failOnCallWriter{failOn: 1}).Execute(ctx, command.NewGreetCommand("Alice"))
+	tf.AssertEqual("No event on write failure", len(events.events), 0)
+
+	tf.RunTest("No sink - default no-op",
+		usecase.NewGreetUseCase(&recordingWriter{}, usecase.WithEventSink(nil)).
+			Execute(ctx, command.NewGreetCommand("Alice")).IsOk())
+
+	// ========================================================================
+	// Test: Failures are logged with the application layer and request ID
+	// ========================================================================
+
+	logged := &logRecorder{}
+	reqCtx := appctx.WithRequestID(ctx, "req-42")
+	usecase.NewGreetUseCase(&failOnCallWriter{failOn: 1}, usecase.WithLogger(logged)).
+		Execute(reqCtx, command.NewGreetCommand("Alice"))
+	tf.AssertEqual("Write failure - logged", logged.entries, []outbound.LogEntry{
+		{Layer: outbound.LayerApplication, RequestID: "req-42", Message: "greet failed: sink unavailable"},
+	})
+
+	logged = &logRecorder{}
+	usecase.NewGreetUseCase(&recordingWriter{}, usecase.WithLogger(logged)).
+		Execute(reqCtx, command.NewGreetCommand(""))
+	tf.AssertEqual("Validation failure - one entry", len(logged.entries), 1)
+	if len(logged.entries) == 1 {
+		tf.AssertEqual("Validation failure - layer", logged.entries[0].Layer, outbound.LayerApplication)
+		tf.AssertEqual("Validation failure - request ID", logged.entries[0].RequestID, "req-42")
+	}
+
+	logged = &logRecorder{}
+	usecase.NewGreetUseCase(&recordingWriter{}, usecase.WithLogger(logged)).
+		Execute(reqCtx, command.NewGreetCommand("Alice"))
+	tf.AssertEqual("Success - nothing logged", len(logged.entries), 0)
+
+	// ========================================================================
+	// Test: Nil collaborators keep the defaults
+	// ========================================================================
+
+	for _, tc := range []struct {
+		name string
+		opt  usecase.GreetOption
+		cmd  command.GreetCommand
+		ok   bool
+	}{
+		{"Nil metrics", usecase.WithMetrics(nil), command.NewGreetCommand("Alice"), true},
+		{"Nil logger", usecase.WithLogger(nil), command.NewGreetCommand(""), false},
+		{"Nil clock", usecase.WithClock(nil), command.NewGreetCommand("Alice"), true},
+		{"Nil greeting service", usecase.WithGreetingService(nil), command.NewGreetCommand("Alice"), true},
+		{"Nil histogram", usecase.WithHistogram(nil), command.NewGreetCommand("Alice"), true},
+	} {
+		var result domerr.Result[model.Unit]
+		panicked := panics(func() {
+			result = usecase.NewGreetUseCase(&recordingWriter{}, tc.opt).Execute(ctx, tc.cmd)
+		})
+		tf.RunTest(tc.name+" - no panic", !panicked)
+		tf.AssertEqual(tc.name+" - outcome unchanged", !panicked && result.IsOk(), tc.ok)
+	}
+
+	// ========================================================================
+	// Test: One outcome counter per greet, named by error kind
+	// ========================================================================
+
+	counters := &counterRecorder{}
+	countedWith := func(w outbound.WriterPort) *usecase.GreetUseCase[outbound.WriterPort] {
+		return usecase.NewGreetUseCase(w, usecase.WithMetrics(counters))
+	}
+	notFound := writerFunc(func(context.Context, string) domerr.Result[model.Unit] {
+		return domerr.Err[model.Unit](domerr.NewNotFoundError("no such recipient"))
+	})
+	for _, tc := range []struct {
+		name   string
+		writer outbound.WriterPort
+		input  string
+		want   string
+	}{
+		{"Counter - success", &recordingWriter{}, "Alice", usecase.MetricGreetSuccess},
+		{"Counter - validation error", &recordingWriter{}, "", usecase.MetricGreetValidationError},
+		{"Counter - infrastructure error", &failOnCallWriter{failOn: 1}, "Alice", usecase.MetricGreetInfrastructureError},
+		{"Counter - not found error", notFound, "Alice", usecase.MetricGreetNotFoundError},
+	} {
+		counters.names = nil
+		countedWith(tc.writer).Execute(ctx, command.NewGreetCommand(tc.input))
+		tf.AssertEqual(tc.name, counters.names, []string{tc.want})
+	}
+
+	// ========================================================================
+	// Test: Greeting length metric and warning threshold
+	// ========================================================================
+
+	lengths := &histogramRecorder{}
+	logs := &logRecorder{}
+	observed := usecase.NewGreetUseCase(&recordingWriter{}, usecase.WithHistogram(lengths),
+		usecase.WithLogger(logs), usecase.WithGreetingLengthWarning(16))
+	observed.Execute(ctx, command.NewGreetCommand("Bob").WithTimes(2))
+	tf.AssertEqual("Length - recorded once per greet, in characters", lengths.observations,
+		[]observation{{name: usecase.MetricGreetingLength, value: 11}})
+	tf.AssertEqual("Length at or below threshold - no warning", len(logs.messages), 0)
+
+	lengths.observations = nil
+	observed.Execute(appctx.WithTenant(ctx, "acme"), command.NewGreetCommand("José"))
+	tf.AssertEqual("Length - final message, runes not bytes", lengths.observations,
+		[]observation{{name: usecase.MetricGreetingLength, value: 19}})
+	tf.AssertEqual("Length above threshold - warning logged", logs.messages,
+		[]string{"greeting length 19 exceeds warning threshold 16"})
+
+	lengths.observations, logs.messages = nil, nil
+	observed.Execute(ctx, command.NewGreetCommand(""))
+	tf.AssertEqual("Invalid name - nothing recorded", len(lengths.observations), 0)
+
+	logs.messages = nil
+	usecase.NewGreetUseCase(&recordingWriter{}, usecase.WithLogger(logs)).
+		Execute(ctx, command.NewGreetCommand(strings.Repeat("x", 100)))
+	tf.AssertEqual("No threshold - never warns", len(logs.messages), 0)
+
+	// ========================================================================
+	// Test: Nil writer - constructed, Execute reports InfrastructureError
+	// ========================================================================
+
+	var nilFunc writerFunc
+	nilFuncUC := usecase.NewGreetUseCase(nilFunc)
+	tf.RunTest("Nil WriterFunc - constructed", nilFuncUC != nil)
+	result = nilFuncUC.Execute(ctx, command.NewGreetCommand("Alice"))
+	tf.AssertError("Nil WriterFunc - InfrastructureError", result, domerr.InfrastructureError)
+	tf.AssertEqual("Nil WriterFunc - message", result.ErrorInfo().Message, "writer not configured")
+
+	tf.AssertError("Nil pointer writer - InfrastructureError",
+		usecase.NewGreetUseCase((*recordingWriter)(nil)).Execute(ctx, command.NewGreetCommand("Alice")),
+		domerr.InfrastructureError)
+	tf.AssertError("Nil interface writer - InfrastructureError",
+		usecase.NewGreetUseCase[outbound.WriterPort](nil).Execute(ctx, command.NewGreetCommand("Alice")),
+		domerr.InfrastructureError)
+	tf.AssertError("Nil writer - validation still first",
+		usecase.NewGreetUseCase(nilFunc).Execute(ctx, command.NewGreetCommand("")),
+		domerr.ValidationError)
+
+	events.events = nil
+	usecase.NewGreetUseCase(nilFunc, usecase.WithEventSink(events.sink)).
+		Execute(ctx, command.NewGreetCommand("Alice"))
+	tf.AssertEqual("Nil writer - no event", len(events.events), 0)
+
+	var called bool
+	live := writerFunc(func(context.Context, string) domerr.Result[model.Unit] {
+		called = true
+		return domerr.Ok(model.UnitValue)
+	})
+	tf.RunTest("Non-nil WriterFunc - IsOk", usecase.NewGreetUseCase(live).Execute(ctx, command.NewGreetCommand("Alice")).IsOk())
+	tf.RunTest("Non-nil WriterFunc - called", called)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: usecase
+// Description: Health check use case
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/abitofhelp/hybrid_app_go/application/command"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+// HealthUseCase reports liveness for deployment probes.
+//
+// It has no dependencies today: if the process can run this method, it is
+// alive. It demonstrates how non-greeting endpoints get their own use case
+// instead of growing GreetUseCase.
+//
+// Implements: inbound.HealthPort interface
+type HealthUseCase struct{}
+
+// NewHealthUseCase creates a new HealthUseCase.
+func NewHealthUseCase() *HealthUseCase {
+	return &HealthUseCase{}
+}
+
+// Execute runs the liveness check.
+//
+// Contract:
+//   - Post: Returns Ok(Unit) unless ctx is already cancelled
+//   - Post: Returns Err(InfrastructureError) if ctx is cancelled
+func (uc *HealthUseCase) Execute(ctx context.Context, _ command.HealthCommand) domerr.Result[model.Unit] {
+	if err := ctx.Err(); err != nil {
+		return domerr.Err[model.Unit](domerr.NewInfrastructureError(fmt.Sprintf("health check cancelled: %v", err)))
+	}
+	return domerr.Ok(model.UnitValue)
+}
@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_app_go/application/command"
+	"github.com/abitofhelp/hybrid_app_go/application/port/inbound"
+	"github.com/abitofhelp/hybrid_app_go/application/usecase"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+)
+
+// Compile-time check: HealthUseCase satisfies the inbound HealthPort contract.
+var _ inbound.HealthPort = (*usecase.HealthUseCase)(nil)
+
+// TestApplicationUseCaseHealth tests the liveness use case.
+func TestApplicationUseCaseHealth(t *testing.T) {
+	tf := test.New("Application.UseCase.Health")
+
+	// ========================================================================
+	// Test: Healthy process returns Ok
+	// ========================================================================
+
+	uc := usecase.NewHealthUseCase()
+	tf.RunTest("Healthy - IsOk",
+		uc.Execute(context.Background(), command.NewHealthCommand()).IsOk())
+
+	// ========================================================================
+	// Test: Cancelled context reports InfrastructureError
+	// ========================================================================
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	tf.AssertError("Cancelled - InfrastructureError",
+		uc.Execute(ctx, command.NewHealthCommand()), domerr.InfrastructureError)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
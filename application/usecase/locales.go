@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: usecase
+// Description: List-locales use case
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/abitofhelp/hybrid_app_go/application/command"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/valueobject"
+)
+
+// LocaleSampleName is the name used to render each locale's sample greeting.
+const LocaleSampleName = "Alice"
+
+// LocalesUseCase lists the supported greeting locales.
+//
+// It reads the domain's locale table (valueobject.SupportedLocales) and
+// renders samples with Person.GreetingMessageFor, so the listing can never
+// drift from what greeting actually supports.
+//
+// Implements: inbound.LocalesPort interface
+type LocalesUseCase struct{}
+
+// NewLocalesUseCase creates a new LocalesUseCase.
+func NewLocalesUseCase() *LocalesUseCase {
+	return &LocalesUseCase{}
+}
+
+// Execute returns every supported locale with a sample greeting.
+//
+// Contract:
+//   - Post: Returns Ok(locales) in SupportedLocales order (sorted by code)
+//   - Post: Returns Err(InfrastructureError) if ctx is cancelled
+func (uc *LocalesUseCase) Execute(ctx context.Context, _ command.LocalesCommand) domerr.Result[[]model.LocaleInfo] {
+	if err := ctx.Err(); err != nil {
+		return domerr.Err[[]model.LocaleInfo](domerr.NewInfrastructureError(fmt.Sprintf("list locales cancelled: %v", err)))
+	}
+
+	sample := valueobject.CreatePerson(LocaleSampleName).Value()
+	locales := valueobject.SupportedLocales()
+	infos := make([]model.LocaleInfo, 0, len(locales))
+	for _, locale := range locales {
+		infos = append(infos, model.LocaleInfo{
+			Code:   locale.Code(),
+			Sample: sample.GreetingMessageFor(locale).UnwrapOr(""),
+		})
+	}
+	return domerr.Ok(infos)
+}
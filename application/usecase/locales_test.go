@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package usecase_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_app_go/application/command"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	"github.com/abitofhelp/hybrid_app_go/application/port/inbound"
+	"github.com/abitofhelp/hybrid_app_go/application/usecase"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+)
+
+// Compile-time check: LocalesUseCase satisfies the inbound LocalesPort contract.
+var _ inbound.LocalesPort = (*usecase.LocalesUseCase)(nil)
+
+// TestApplicationUseCaseLocales tests the list-locales use case.
+func TestApplicationUseCaseLocales(t *testing.T) {
+	tf := test.New("Application.UseCase.Locales")
+
+	// ========================================================================
+	// Test: Every supported locale with its sample greeting
+	// ========================================================================
+
+	uc := usecase.NewLocalesUseCase()
+	result := uc.Execute(context.Background(), command.NewLocalesCommand())
+	tf.RunTest("List - IsOk", result.IsOk())
+	tf.AssertEqual("List - codes and samples", result.Value(), []model.LocaleInfo{
+		{Code: "en", Sample: "Hello, Alice!"},
+		{Code: "es", Sample: "¡Hola, Alice!"},
+		{Code: "fr", Sample: "Bonjour, Alice !"},
+	})
+
+	// ========================================================================
+	// Test: Cancelled context reports InfrastructureError
+	// ========================================================================
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	tf.AssertError("Cancelled - InfrastructureError",
+		uc.Execute(ctx, command.NewLocalesCommand()), domerr.InfrastructureError)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
@@ -0,0 +1,281 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: usecase
+// Description: Optional collaborators for use cases
+
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"time"
+	"unicode/utf8"
+
+	"github.com/abitofhelp/hybrid_app_go/application/command"
+	"github.com/abitofhelp/hybrid_app_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/event"
+	"github.com/abitofhelp/hybrid_app_go/domain/service"
+	"github.com/abitofhelp/hybrid_app_go/domain/valueobject"
+)
+
+// Counter names reported by GreetUseCase through the MetricsPort. Error
+// counters are "greet." plus the ErrorKind's Code().
+const (
+	MetricGreetSuccess             = "greet.success"
+	MetricGreetValidationError     = "greet.validation_error"
+	MetricGreetInfrastructureError = "greet.infrastructure_error"
+	MetricGreetNotFoundError       = "greet.not_found_error"
+	MetricGreetUnknownError        = "greet.unknown_error"
+)
+
+// MetricGreetingLength is the histogram of greeting lengths, in
+// characters, reported through WithHistogram.
+const MetricGreetingLength = "greet.greeting_length"
+
+// DefaultMaxBatchSize is the largest batch GreetAllUseCase accepts unless
+// overridden with WithMaxBatchSize.
+const DefaultMaxBatchSize = 1000
+
+// greetOptions holds optional, non-generic settings for the greet use cases.
+//
+// Required dependencies (the writer) remain type parameters for static
+// dispatch; optional ones live here so adding them never changes the
+// use case's type or the bootstrap wiring.
+type greetOptions struct {
+	metrics      outbound.MetricsPort
+	histogram    outbound.HistogramPort
+	lengthWarn   int
+	log          outbound.LoggerFunc
+	events       outbound.EventSinkFunc
+	clock        outbound.ClockPort
+	honorific    outbound.HonorificFunc
+	rand         outbound.RandFunc
+	greeter      service.GreetingService
+	maxBatchSize int
+	singleWrite  bool
+}
+
+// GreetOption configures an optional greet use case setting or collaborator.
+type GreetOption func(*greetOptions)
+
+// WithMetrics reports greet outcomes as counters on m. A nil m keeps the
+// default no-op.
+func WithMetrics(m outbound.MetricsPort) GreetOption {
+	return func(o *greetOptions) {
+		if m != nil {
+			o.metrics = m
+		}
+	}
+}
+
+// WithHistogram reports the length of each rendered greeting, in
+// characters and including any tenant prefix, as MetricGreetingLength on h.
+// A nil h keeps the default no-op.
+func WithHistogram(h outbound.HistogramPort) GreetOption {
+	return func(o *greetOptions) {
+		if h != nil {
+			o.histogram = h
+		}
+	}
+}
+
+// WithGreetingLengthWarning logs (through WithLogger) each rendered
+// greeting longer than n characters. Values below 1 disable the warning
+// (the default).
+func WithGreetingLengthWarning(n int) GreetOption {
+	return func(o *greetOptions) {
+		o.lengthWarn = n
+	}
+}
+
+// WithLogger reports greet failures to sink, tagged with the application
+// layer and the request ID from the context. A nil sink keeps the default
+// no-op.
+func WithLogger(sink outbound.LoggerPort) GreetOption {
+	return func(o *greetOptions) {
+		if sink != nil {
+			o.log = outbound.LogWith(sink, outbound.LayerApplication)
+		}
+	}
+}
+
+// WithEventSink delivers a domain event to sink after each successful
+// greeting (e.g., event.PersonGreeted for analytics or auditing).
+// A nil sink keeps the default no-op.
+func WithEventSink(sink outbound.EventSinkFunc) GreetOption {
+	return func(o *greetOptions) {
+		if sink != nil {
+			o.events = sink
+		}
+	}
+}
+
+// WithClock sets the clock used to timestamp domain events
+// (default: the system clock). A nil c keeps the default.
+func WithClock(c outbound.ClockPort) GreetOption {
+	return func(o *greetOptions) {
+		if c != nil {
+			o.clock = c
+		}
+	}
+}
+
+// WithHonorific consults infer for each validated name and, when it
+// returns true, greets the person with that honorific as their title
+// (e.g., "Hello, Dr. Alice!"). The default infers nothing.
+func WithHonorific(infer outbound.HonorificFunc) GreetOption {
+	return func(o *greetOptions) {
+		o.honorific = infer
+	}
+}
+
+// WithRand sets the source of random choices, such as the phrase picked
+// by CasualGreetUseCase (default: math/rand's rand.Int). A nil f keeps
+// the default.
+func WithRand(f outbound.RandFunc) GreetOption {
+	return func(o *greetOptions) {
+		if f != nil {
+			o.rand = f
+		}
+	}
+}
+
+// WithGreetingService replaces the greeting strategy (default:
+// service.DefaultGreetingService, i.e., Person.GreetingMessage). Commands
+// with non-default punctuation need g to implement
+// service.PunctuatedGreetingService. A nil g
+// keeps the default.
+func WithGreetingService(g service.GreetingService) GreetOption {
+	return func(o *greetOptions) {
+		if g != nil {
+			o.greeter = g
+		}
+	}
+}
+
+// WithMaxBatchSize limits how many names GreetAllUseCase accepts per
+// command. Values below 1 keep the default.
+func WithMaxBatchSize(n int) GreetOption {
+	return func(o *greetOptions) {
+		if n >= 1 {
+			o.maxBatchSize = n
+		}
+	}
+}
+
+// WithSingleWrite makes GreetAllUseCase build the whole batch in one
+// buffer and write it with a single Write call (greetings joined by
+// newlines) instead of one call per greeting. Use it with line-oriented
+// writers such as ConsoleWriter, where per-call overhead (a syscall per
+// line) dominates large batches.
+func WithSingleWrite() GreetOption {
+	return func(o *greetOptions) {
+		o.singleWrite = true
+	}
+}
+
+// defaultGreetOptions returns options with no-op collaborators and
+// default limits.
+func defaultGreetOptions() greetOptions {
+	return greetOptions{
+		metrics:      noopMetrics{},
+		histogram:    noopMetrics{},
+		log:          outbound.LogWith(noopLogger{}, outbound.LayerApplication),
+		events:       func(context.Context, event.PersonGreeted) {},
+		clock:        systemClock{},
+		rand:         rand.Int,
+		greeter:      service.DefaultGreetingService{},
+		maxBatchSize: DefaultMaxBatchSize,
+	}
+}
+
+// newGreetOptions applies opts over the defaults.
+func newGreetOptions(opts []GreetOption) greetOptions {
+	options := defaultGreetOptions()
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return options
+}
+
+// renderPerson validates cmd's name via the domain, then applies the
+// requested letter case (also validated by the domain) and any inferred
+// honorific.
+func (o greetOptions) renderPerson(cmd command.GreetCommand) domerr.Result[valueobject.Person] {
+	return domerr.AndThenTo(valueobject.CreatePerson(cmd.GetName()),
+		func(person valueobject.Person) domerr.Result[valueobject.Person] {
+			return domerr.AndThenTo(valueobject.CreateNameCase(cmd.GetCase()), person.WithCase)
+		}).AndThen(o.withHonorific)
+}
+
+// greeting returns the greeting strategy's message for person. A
+// non-default punctuation goes through the strategy too, so it needs a
+// service.PunctuatedGreetingService (the default is one); any other
+// strategy rejects it rather than being silently bypassed.
+func (o greetOptions) greeting(person valueobject.Person, punctuation string) domerr.Result[string] {
+	if punctuation == valueobject.DefaultPunctuation {
+		return domerr.Ok(o.greeter.Greet(person))
+	}
+	punctuated, ok := o.greeter.(service.PunctuatedGreetingService)
+	if !ok {
+		return domerr.Err[string](domerr.NewValidationError(fmt.Sprintf(
+			"the configured greeting strategy does not support punctuation %q", punctuation)))
+	}
+	return punctuated.GreetWithPunctuation(person, punctuation)
+}
+
+// observeGreeting reports the length of message, the final greeting about
+// to be written, and logs it when it exceeds the warning threshold.
+func (o greetOptions) observeGreeting(ctx context.Context, message string) {
+	length := utf8.RuneCountInString(message)
+	o.histogram.RecordValue(MetricGreetingLength, float64(length), nil)
+	if o.lengthWarn >= 1 && length > o.lengthWarn {
+		o.log(ctx, fmt.Sprintf("greeting length %d exceeds warning threshold %d", length, o.lengthWarn))
+	}
+}
+
+// renderGreeting validates name via the domain, applies any inferred
+// honorific, and greets the resulting Person with the configured strategy.
+func (o greetOptions) renderGreeting(name string) domerr.Result[string] {
+	return domerr.MapTo(domerr.AndThenTo(valueobject.CreatePerson(name), o.withHonorific), o.greeter.Greet)
+}
+
+// withHonorific rebuilds person with the inferred honorific as its title.
+// Without an inference function, when none applies, or when person already
+// has a title, person is returned unchanged.
+//
+// Contract:
+//   - Post: GetName() of the result equals person.GetName()
+//   - Post: Returns Err(ValidationError) if the honorific breaks the
+//     domain title rules
+func (o greetOptions) withHonorific(person valueobject.Person) domerr.Result[valueobject.Person] {
+	if o.honorific == nil || person.GetTitle().IsSome() {
+		return domerr.Ok(person)
+	}
+	title, ok := o.honorific(person.GetName())
+	if !ok {
+		return domerr.Ok(person)
+	}
+	return valueobject.NewPersonBuilder().WithName(person.GetName()).WithTitle(title).Build()
+}
+
+// noopMetrics is the default MetricsPort and HistogramPort; it records
+// nothing.
+type noopMetrics struct{}
+
+func (noopMetrics) IncrementCounter(string, map[string]string) {}
+
+func (noopMetrics) RecordValue(string, float64, map[string]string) {}
+
+// systemClock is the default ClockPort (time.Now); WithClock substitutes
+// a fixed clock in tests.
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+// noopLogger is the default LoggerPort; it records nothing.
+type noopLogger struct{}
+
+func (noopLogger) Log(outbound.LogEntry) {}
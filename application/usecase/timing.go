@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: usecase
+// Description: Timing decorator for the greet input port
+
+package usecase
+
+import (
+	"context"
+
+	"github.com/abitofhelp/hybrid_app_go/application/command"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	"github.com/abitofhelp/hybrid_app_go/application/port/inbound"
+	"github.com/abitofhelp/hybrid_app_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+// MetricGreetDuration is the timing reported by TimedGreetUseCase.
+const MetricGreetDuration = "greet.duration"
+
+// Outcome tag values reported with MetricGreetDuration (tag "outcome").
+const (
+	OutcomeOK              = "ok"
+	OutcomeValidationError = "validation_error"
+	OutcomeInfraError      = "infra_error"
+	OutcomeNotFoundError   = "not_found_error"
+	OutcomeUnknownError    = "unknown_error"
+)
+
+// TimedGreetUseCase is a decorator over any GreetPort that measures each
+// Execute and reports the duration, tagged with its outcome. Timing stays
+// out of the core use case, and any GreetPort can be timed.
+//
+// Static Dispatch:
+//   - Generic over the decorated port, like the writer decorators
+//
+// Implements: inbound.GreetPort
+type TimedGreetUseCase[UC inbound.GreetPort, C outbound.ClockPort] struct {
+	inner  UC
+	timing outbound.TimingPort
+	clock  C
+}
+
+// NewTimedGreetUseCase wraps uc so every Execute is timed with clock and
+// reported to timing as MetricGreetDuration.
+//
+// Usage:
+//
+//	uc := usecase.NewTimedGreetUseCase(usecase.NewGreetUseCase(writer), registry, adapter.SystemClock{})
+func NewTimedGreetUseCase[UC inbound.GreetPort, C outbound.ClockPort](uc UC, timing outbound.TimingPort, clock C) *TimedGreetUseCase[UC, C] {
+	return &TimedGreetUseCase[UC, C]{inner: uc, timing: timing, clock: clock}
+}
+
+// Execute delegates to the wrapped use case and records how long it took.
+//
+// Contract:
+//   - Post: The wrapped use case's Result is returned unchanged
+//   - Post: Exactly one duration is recorded per call, tagged with one
+//     of the Outcome* values
+func (t *TimedGreetUseCase[UC, C]) Execute(ctx context.Context, cmd command.GreetCommand) domerr.Result[model.Unit] {
+	start := t.clock.Now()
+	result := t.inner.Execute(ctx, cmd)
+	t.timing.RecordDuration(MetricGreetDuration, t.clock.Now().Sub(start),
+		map[string]string{"outcome": outcomeTag(result)})
+	return result
+}
+
+// outcomeTag classifies a greet Result as one of the Outcome* values.
+func outcomeTag(result domerr.Result[model.Unit]) string {
+	if result.IsOk() {
+		return OutcomeOK
+	}
+	switch result.ErrorInfo().Kind {
+	case domerr.ValidationError:
+		return OutcomeValidationError
+	case domerr.InfrastructureError:
+		return OutcomeInfraError
+	case domerr.NotFoundError:
+		return OutcomeNotFoundError
+	default:
+		return OutcomeUnknownError
+	}
+}
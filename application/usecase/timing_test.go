@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_app_go/application/command"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	"github.com/abitofhelp/hybrid_app_go/application/port/inbound"
+	"github.com/abitofhelp/hybrid_app_go/application/usecase"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+)
+
+// steppingClock is a ClockPort test double that advances by step on every
+// read, so a timed call observes exactly one step.
+type steppingClock struct {
+	now  time.Time
+	step time.Duration
+}
+
+func (c *steppingClock) Now() time.Time {
+	now := c.now
+	c.now = c.now.Add(c.step)
+	return now
+}
+
+// timing is one recorded duration.
+type timing struct {
+	name string
+	d    time.Duration
+	tags map[string]string
+}
+
+// recordingTiming is a TimingPort test double that records every duration.
+type recordingTiming struct {
+	recorded []timing
+}
+
+func (r *recordingTiming) RecordDuration(name string, d time.Duration, tags map[string]string) {
+	r.recorded = append(r.recorded, timing{name: name, d: d, tags: tags})
+}
+
+// stubGreetPort is a GreetPort test double that always fails with err.
+type stubGreetPort struct {
+	err domerr.ErrorType
+}
+
+func (s stubGreetPort) Execute(context.Context, command.GreetCommand) domerr.Result[model.Unit] {
+	return domerr.Err[model.Unit](s.err)
+}
+
+// Compile-time check: the decorator is itself a GreetPort.
+var _ inbound.GreetPort = (*usecase.TimedGreetUseCase[*usecase.GreetUseCase[*recordingWriter], *steppingClock])(nil)
+
+// TestApplicationUseCaseTiming tests the timing decorator.
+func TestApplicationUseCaseTiming(t *testing.T) {
+	tf := test.New("Application.UseCase.Timing")
+	ctx := context.Background()
+
+	cases := []struct {
+		name    string
+		writer  *failOnCallWriter
+		cmd     command.GreetCommand
+		outcome string
+		ok      bool
+	}{
+		{"Ok", &failOnCallWriter{}, command.NewGreetCommand("Alice"), usecase.OutcomeOK, true},
+		{"Validation error", &failOnCallWriter{}, command.NewGreetCommand(""), usecase.OutcomeValidationError, false},
+		{"Infrastructure error", &failOnCallWriter{failOn: 1}, command.NewGreetCommand("Alice"), "infra_error", false},
+	}
+
+	for _, tc := range cases {
+		sink := &recordingTiming{}
+		clock := &steppingClock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), step: 250 * time.Millisecond}
+		timed := usecase.NewTimedGreetUseCase(usecase.NewGreetUseCase(tc.writer), sink, clock)
+
+		result := timed.Execute(ctx, tc.cmd)
+		tf.AssertEqual(tc.name+" - Result passed through", result.IsOk(), tc.ok)
+		tf.AssertEqual(tc.name+" - one duration recorded", len(sink.recorded), 1)
+		if len(sink.recorded) == 1 {
+			got := sink.recorded[0]
+			tf.AssertEqual(tc.name+" - metric name", got.name, usecase.MetricGreetDuration)
+			tf.AssertEqual(tc.name+" - duration from clock", got.d, 250*time.Millisecond)
+			tf.AssertEqual(tc.name+" - outcome tag", got.tags, map[string]string{"outcome": tc.outcome})
+		}
+	}
+
+	// ========================================================================
+	// Test: Other error kinds get their own tag; errors pass unchanged
+	// ========================================================================
+
+	sink := &recordingTiming{}
+	notFound := domerr.NewNotFoundError("no such person")
+	timed := usecase.NewTimedGreetUseCase(stubGreetPort{err: notFound}, sink, &steppingClock{step: time.Second})
+	result := timed.Execute(ctx, command.NewGreetCommand("Alice"))
+	tf.AssertError("NotFound - passed through", result, domerr.NotFoundError)
+	tf.AssertEqual("NotFound - tagged not_found_error", sink.recorded[0].tags["outcome"], usecase.OutcomeNotFoundError)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
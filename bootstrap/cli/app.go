@@ -0,0 +1,209 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: cli
+// Description: Startup/shutdown lifecycle for embedding the CLI
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/abitofhelp/hybrid_app_go/infrastructure/adapter"
+	"github.com/abitofhelp/hybrid_app_go/presentation/adapter/cli/command"
+)
+
+// App is the CLI wired from a Config, with an explicit lifecycle for
+// embedders that must release resources on shutdown:
+//
+//	app := cli.New(cfg)
+//	if err := app.Start(ctx); err != nil { ... }
+//	code := app.Run(os.Args)
+//	if err := app.Stop(shutdownCtx); err != nil { ... }
+//
+// Ownership: App owns cfg.Output. On Stop it is flushed if it has a
+// Flush() error method (e.g., *bufio.Writer) and then closed if it is an
+// io.Closer (e.g., *os.File) other than os.Stdout or os.Stderr.
+// cfg.ErrOutput is only written to.
+type App struct {
+	cfg Config
+
+	mu      sync.Mutex
+	ctx     context.Context
+	started bool
+	stopped bool
+
+	stopOnce sync.Once
+	stopDone chan struct{}
+	stopErr  error
+}
+
+// New returns an App for cfg. A nil cfg.Output means os.Stdout, a nil
+// cfg.ErrOutput means os.Stderr, and a nil cfg.Input means os.Stdin.
+// Nothing is validated or started until Start.
+func New(cfg Config) *App {
+	if cfg.Output == nil {
+		cfg.Output = os.Stdout
+	}
+	if cfg.ErrOutput == nil {
+		cfg.ErrOutput = os.Stderr
+	}
+	if cfg.Input == nil {
+		cfg.Input = os.Stdin
+	}
+	return &App{cfg: cfg, stopDone: make(chan struct{})}
+}
+
+// Start validates the configuration and makes ctx the parent of every
+// use case call made by Run.
+//
+// Contract:
+//   - Post: Returns an error if the format is unknown or the timeout is
+//     negative, or if the App was already started or stopped
+func (a *App) Start(ctx context.Context) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	switch {
+	case a.stopped:
+		return errors.New("app already stopped")
+	case a.started:
+		return errors.New("app already started")
+	case a.cfg.Format != "" && !validFormat(a.cfg.Format):
+		return fmt.Errorf("unknown output format %q (want %s or %s)", a.cfg.Format, FormatText, FormatCSV)
+	case a.cfg.Timeout < 0:
+		return fmt.Errorf("timeout must not be negative (got %s)", a.cfg.Timeout)
+	}
+	a.ctx = ctx
+	a.started = true
+	return nil
+}
+
+// Run dispatches args (os.Args-shaped) to a command and returns its exit
+// code. Command-line wiring flags are not re-applied here; RunContext
+// applies them to the Config before constructing the App.
+//
+// Contract:
+//   - Post: Returns 1 with a message on cfg.ErrOutput if the App is not
+//     running (Start not called, or already stopped)
+func (a *App) Run(args []string) int {
+	a.mu.Lock()
+	running := a.started && !a.stopped
+	ctx := a.ctx
+	a.mu.Unlock()
+	if !running {
+		fmt.Fprintln(a.cfg.ErrOutput, "Error: app is not running")
+		return command.ExitFailure
+	}
+
+	cmdOpts := []command.CommandOption{command.WithContext(ctx), command.WithOutput(a.cfg.Output),
+		command.WithErrorOutput(a.cfg.ErrOutput)}
+	if a.cfg.Timeout > 0 {
+		cmdOpts = append(cmdOpts, command.WithTimeout(a.cfg.Timeout))
+	}
+
+	// ========================================================================
+	// Step 1: Create Infrastructure adapter for the configured format
+	// ========================================================================
+
+	// DEPENDENCY INVERSION in action:
+	// - Application.Port.Outbound.WriterPort defines the interface (port)
+	// - Infrastructure adapters implement the interface
+	// - We instantiate the concrete type here in the composition root
+	// --max-output-bytes caps the encoded byte stream, so the budget sits
+	// between the format adapter and the output
+	out, name := a.cfg.Output, writerName(a.cfg)
+	if limit := outputLimit(args); limit > 0 {
+		out, name = adapter.NewOutputBudgetWriter(out, limit), name+" + output limit"
+	}
+	reader := adapter.NewLimitedLineReader(a.cfg.Input, interactiveMaxLineBytes)
+	if a.cfg.Format == FormatCSV {
+		return runWithInput(adapter.NewCSVWriter(out), name, reader, args, cmdOpts...)
+	}
+	return runWithInput(adapter.NewWriter(out), name, reader, args, cmdOpts...)
+}
+
+// outputLimit returns the --max-output-bytes given to greet (or the legacy
+// form) or greet-all in args, or 0 (unlimited). Arguments that do not
+// parse also give 0; the command reports them.
+func outputLimit(args []string) int64 {
+	if len(args) >= 2 && args[1] == subGreetAll {
+		opts, err := command.ParseBatchOptions(append([]string{args[0] + " " + subGreetAll}, args[2:]...))
+		if err != nil {
+			return 0
+		}
+		return opts.MaxOutputBytes
+	}
+	greetArgs, ok := wiringArgs(args)
+	if !ok {
+		return 0
+	}
+	opts, err := command.ParseOptions(greetArgs)
+	if err != nil {
+		return 0
+	}
+	return opts.MaxOutputBytes
+}
+
+// writerName describes the configured writer for --verbose diagnostics,
+// e.g., "console (stdout)" for the default text output.
+func writerName(cfg Config) string {
+	kind := "console"
+	if cfg.Format == FormatCSV {
+		kind = FormatCSV
+	}
+	switch cfg.Output {
+	case os.Stdout:
+		return kind + " (stdout)"
+	case os.Stderr:
+		return kind + " (stderr)"
+	default:
+		return kind + " (custom)"
+	}
+}
+
+// Stop flushes and closes the output (see App) and waits for that to
+// finish or for ctx to be done, whichever comes first.
+//
+// Stop is idempotent: the release runs once, and every call returns its
+// result (or ctx's error if ctx ends first). Stop may be called without
+// Start, e.g., to release the output after a failed Start.
+func (a *App) Stop(ctx context.Context) error {
+	a.mu.Lock()
+	a.stopped = true
+	a.mu.Unlock()
+
+	a.stopOnce.Do(func() {
+		go func() {
+			a.stopErr = release(a.cfg.Output)
+			close(a.stopDone)
+		}()
+	})
+
+	select {
+	case <-a.stopDone:
+		return a.stopErr
+	case <-ctx.Done():
+		return fmt.Errorf("stop: %w", ctx.Err())
+	}
+}
+
+// release flushes then closes w where it supports that; the process's
+// standard streams are never closed.
+func release(w io.Writer) error {
+	var errs []error
+	if f, ok := w.(interface{ Flush() error }); ok {
+		if err := f.Flush(); err != nil {
+			errs = append(errs, fmt.Errorf("flush output: %w", err))
+		}
+	}
+	if c, ok := w.(io.Closer); ok && w != os.Stdout && w != os.Stderr {
+		if err := c.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("close output: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+)
+
+// blockingFlusher is an output whose Flush blocks until release is closed.
+type blockingFlusher struct {
+	bytes.Buffer
+	release chan struct{}
+}
+
+func (b *blockingFlusher) Flush() error {
+	<-b.release
+	return nil
+}
+
+// TestBootstrapCLIApp tests the App startup/shutdown lifecycle.
+func TestBootstrapCLIApp(t *testing.T) {
+	tf := test.New("Bootstrap.CLI.App")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Stop flushes a buffered writer
+	// ========================================================================
+
+	var sink bytes.Buffer
+	buffered := bufio.NewWriter(&sink)
+	app := New(NewConfig(WithOutput(buffered)))
+	tf.RunTest("Buffered - Start ok", app.Start(ctx) == nil)
+	tf.AssertEqual("Buffered - Run exit code 0", app.Run([]string{"greeter", "Alice"}), 0)
+	tf.AssertEqual("Buffered - nothing flushed before Stop", sink.String(), "")
+	tf.RunTest("Buffered - Stop ok", app.Stop(ctx) == nil)
+	tf.AssertEqual("Buffered - flushed on Stop", sink.String(), "Hello, Alice!\n")
+
+	// ========================================================================
+	// Test: Stop closes a file handle; Stop is idempotent
+	// ========================================================================
+
+	path := filepath.Join(t.TempDir(), "greetings.txt")
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	app = New(NewConfig(WithOutput(file)))
+	app.Start(ctx)
+	app.Run([]string{"greeter", "Bob"})
+	tf.RunTest("File - Stop ok", app.Stop(ctx) == nil)
+	_, writeErr := file.WriteString("more")
+	tf.RunTest("File - handle closed", errors.Is(writeErr, os.ErrClosed))
+	content, _ := os.ReadFile(path)
+	tf.AssertEqual("File - greeting persisted", string(content), "Hello, Bob!\n")
+	tf.RunTest("File - second Stop ok (no double close)", app.Stop(ctx) == nil)
+
+	// ========================================================================
+	// Test: Standard streams are never closed
+	// ========================================================================
+
+	app = New(NewConfig())
+	tf.RunTest("Stdout - Stop ok", app.Stop(ctx) == nil)
+	_, statErr := os.Stdout.Stat()
+	tf.RunTest("Stdout - still open", statErr == nil)
+
+	// ========================================================================
+	// Test: Lifecycle misuse
+	// ========================================================================
+
+	var errOut bytes.Buffer
+	app = New(NewConfig(WithOutput(&bytes.Buffer{}), WithErrorOutput(&errOut)))
+	tf.AssertEqual("Run before Start - exit code 1", app.Run([]string{"greeter", "Alice"}), 1)
+	tf.AssertEqual("Run before Start - error on ErrOutput", errOut.String(), "Error: app is not running\n")
+	app.Start(ctx)
+	tf.RunTest("Start twice - error", app.Start(ctx) != nil)
+	app.Stop(ctx)
+	errOut.Reset()
+	tf.AssertEqual("Run after Stop - exit code 1", app.Run([]string{"greeter", "Alice"}), 1)
+	tf.AssertEqual("Run after Stop - error on ErrOutput", errOut.String(), "Error: app is not running\n")
+	tf.RunTest("Start after Stop - error", app.Start(ctx) != nil)
+
+	// ========================================================================
+	// Test: --verbose names the configured writer
+	// ========================================================================
+
+	for _, tc := range []struct {
+		name string
+		cfg  Config
+		want string
+	}{
+		{"Writer name - default", NewConfig(), "console (stdout)"},
+		{"Writer name - stderr", NewConfig(WithOutput(os.Stderr)), "console (stderr)"},
+		{"Writer name - csv", NewConfig(WithFormat(FormatCSV)), "csv (stdout)"},
+		{"Writer name - custom", NewConfig(WithOutput(&bytes.Buffer{})), "console (custom)"},
+	} {
+		tf.AssertEqual(tc.name, writerName(tc.cfg), tc.want)
+	}
+
+	var diag bytes.Buffer
+	app = New(NewConfig(WithOutput(&bytes.Buffer{}), WithErrorOutput(&diag)))
+	app.Start(ctx)
+	app.Run([]string{"greeter", "--verbose", "Alice"})
+	app.Stop(ctx)
+	tf.RunTest("Verbose - writer line", strings.Contains(diag.String(), "[verbose] writer: console (custom)\n"))
+
+	tf.RunTest("Start - unknown format rejected",
+		New(NewConfig(WithFormat("xml"))).Start(ctx) != nil)
+	tf.RunTest("Start - negative timeout rejected",
+		New(NewConfig(WithTimeout(-time.Second))).Start(ctx) != nil)
+
+	// ========================================================================
+	// Test: Stop honors its context deadline
+	// ========================================================================
+
+	slow := &blockingFlusher{release: make(chan struct{})}
+	app = New(NewConfig(WithOutput(slow)))
+	expired, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	stopErr := app.Stop(expired)
+	tf.RunTest("Deadline - Stop returns DeadlineExceeded", errors.Is(stopErr, context.DeadlineExceeded))
+	close(slow.release)
+	tf.RunTest("Deadline - later Stop sees completion", app.Stop(ctx) == nil)
+
+	// ========================================================================
+	// Test: Config.Input feeds --interactive
+	// ========================================================================
+
+	var greeted bytes.Buffer
+	app = New(NewConfig(WithOutput(&greeted), WithInput(strings.NewReader("Alice\nBob\n\nCarol\n"))))
+	app.Start(ctx)
+	tf.AssertEqual("Interactive - exit code 0", app.Run([]string{"greeter", "--interactive", "-q"}), 0)
+	tf.AssertEqual("Interactive - greeted until blank line", greeted.String(), "Hello, Alice!\nHello, Bob!\n")
+	app.Stop(ctx)
+
+	// ========================================================================
+	// Test: RunWith stops the App (buffered output flushed)
+	// ========================================================================
+
+	sink.Reset()
+	buffered = bufio.NewWriter(&sink)
+	code := RunWith(NewConfig(WithOutput(buffered)), []string{"greeter", "Carol"})
+	tf.AssertEqual("RunWith - exit code 0", code, 0)
+	tf.AssertEqual("RunWith - flushed", sink.String(), "Hello, Carol!\n")
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
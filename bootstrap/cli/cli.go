@@ -36,12 +36,15 @@
 //	import "github.com/abitofhelp/hybrid_app_go/bootstrap/cli"
 //
 //	func main() {
-//	    exitCode := cli.Run(os.Args)
-//	    os.Exit(exitCode)
+//	    cli.Main(os.Args) // Run, then exit the process with its code
 //	}
 package cli
 
 import (
+	"fmt"
+	"os"
+
+	"github.com/abitofhelp/hybrid_app_go/application/port/outbound"
 	"github.com/abitofhelp/hybrid_app_go/application/usecase"
 	"github.com/abitofhelp/hybrid_app_go/infrastructure/adapter"
 	"github.com/abitofhelp/hybrid_app_go/presentation/adapter/cli/command"
@@ -111,25 +114,80 @@ import (
 //   - Post: Returns 0 if application succeeded
 //   - Post: Returns non-zero if application failed
 func Run(args []string) int {
-	// ========================================================================
-	// Step 1: Create Infrastructure adapter
-	// ========================================================================
+	// Default configuration (text output to stdout, no timeout),
+	// overridden by GREETER_* environment variables
+	cfg, err := ConfigFromEnv(NewConfig())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: invalid environment: %v\n", err)
+		return command.ExitFailure
+	}
+	return RunWith(cfg, args)
+}
 
-	// DEPENDENCY INVERSION in action:
-	// - Application.Port.Outward.WriterPort defines the interface (port)
-	// - Infrastructure.Adapter.ConsoleWriter implements the interface
-	// - We instantiate the concrete type here in the composition root
-	consoleWriter := adapter.NewConsoleWriter()
+// exitFunc ends the process with a status code (default: os.Exit).
+// Terminal paths call it instead of os.Exit so tests can capture the code
+// without exiting the test process.
+var exitFunc = os.Exit
 
+// Main runs the application with args (os.Args) and exits the process
+// with the resulting code through exitFunc. It is the whole body of the
+// greeter binary's main function.
+//
+// Contract:
+//   - Post: exitFunc is called exactly once, with Run(args)
+func Main(args []string) {
+	exitFunc(Run(args))
+}
+
+// greet selects the writer for this invocation and runs the greet command.
+//
+// Wiring decisions driven by flags live here (not in presentation), because
+// only the composition root may choose infrastructure:
+//   - --dry-run: route the use case through a no-op writer, so the name is
+//     validated but the real writer is never called
+//   - --interactive: greet names from reader instead of the argument
+//   - --prefix/--suffix: wrap the chosen writer in an AffixWriter
+//
+// --max-output-bytes is wired by App.Run, under the format adapter (see
+// outputLimit).
+//
+// If the arguments don't parse, the real writer is wired and the command
+// itself reports the usage error.
+func greet[W outbound.WriterPort](writer W, writerName string, reader adapter.ReaderFunc, args []string, cmdOpts []command.CommandOption) int {
+	opts, err := command.ParseOptions(args)
+	if err != nil {
+		return runGreet(writer, writerName, args, cmdOpts)
+	}
+	if opts.Interactive {
+		return interactive(writer, reader, args, cmdOpts)
+	}
+	if opts.DryRun {
+		return decorate(adapter.NewNoopWriter(), "dry-run (no-op)", opts, args, cmdOpts)
+	}
+	return decorate(writer, writerName, opts, args, cmdOpts)
+}
+
+// decorate wraps writer for --prefix/--suffix (if given) and runs greet.
+func decorate[W outbound.WriterPort](writer W, writerName string, opts command.Options, args []string, cmdOpts []command.CommandOption) int {
+	if opts.Prefix == "" && opts.Suffix == "" {
+		return runGreet(writer, writerName, args, cmdOpts)
+	}
+	return runGreet(adapter.NewAffixWriter(writer, opts.Prefix, opts.Suffix),
+		writerName+" + prefix/suffix", args, cmdOpts)
+}
+
+// runGreet instantiates the use case and command for writer type W and runs it.
+// writerName describes the writer for --verbose diagnostics.
+func runGreet[W outbound.WriterPort](writer W, writerName string, args []string, cmdOpts []command.CommandOption) int {
 	// ========================================================================
 	// Step 2: Instantiate Use Case with concrete writer type
 	// ========================================================================
 
 	// STATIC DISPATCH via generics:
-	// - GreetUseCase[*adapter.ConsoleWriter] knows the concrete writer type
+	// - GreetUseCase[W] knows the concrete writer type at instantiation
 	// - All calls to writer.Write() are statically dispatched
 	// - Equivalent to Ada: package Greet_UC is new Greet(Writer => Console_Writer.Write)
-	greetUseCase := usecase.NewGreetUseCase[*adapter.ConsoleWriter](consoleWriter)
+	greetUseCase := usecase.NewGreetUseCase[W](writer)
 
 	// ========================================================================
 	// Step 3: Instantiate Command with concrete use case type
@@ -139,7 +197,8 @@ func Run(args []string) int {
 	// - GreetCommand knows the exact use case type
 	// - All calls to useCase.Execute() are statically dispatched
 	// - The entire call chain is resolved at compile time
-	greetCommand := command.NewGreetCommand[*usecase.GreetUseCase[*adapter.ConsoleWriter]](greetUseCase)
+	greetCommand := command.NewGreetCommand[*usecase.GreetUseCase[W]](greetUseCase,
+		append([]command.CommandOption{command.WithWriterName(writerName)}, cmdOpts...)...)
 
 	// ========================================================================
 	// Step 4: Run the application and return exit code
@@ -154,3 +213,41 @@ func Run(args []string) int {
 	//   5. Return an exit code
 	return greetCommand.Run(args)
 }
+
+// runGreetAll instantiates the batch use case and command for writer type W
+// and runs it. Invalid names are skipped (partial success) rather than
+// failing the batch.
+func runGreetAll[W outbound.WriterPort](writer W, args []string, cmdOpts []command.CommandOption) int {
+	greetAllUseCase := usecase.NewGreetAllUseCase[W](writer)
+	greetAllCommand := command.NewGreetAllCommand[*usecase.GreetAllUseCase[W]](greetAllUseCase, cmdOpts...)
+	return greetAllCommand.Run(args)
+}
+
+// interactiveMaxLineBytes bounds one line of --interactive input; names
+// are at most a few hundred bytes, so anything longer is rejected early.
+const interactiveMaxLineBytes = 4 * 1024
+
+// interactive instantiates the streaming use case over reader and writer
+// and runs the --interactive command.
+func interactive[W outbound.WriterPort](writer W, reader adapter.ReaderFunc, args []string, cmdOpts []command.CommandOption) int {
+	streamUseCase := usecase.NewGreetStreamUseCase[adapter.ReaderFunc, W](reader, writer)
+	interactiveCommand := command.NewInteractiveCommand[*usecase.GreetStreamUseCase[adapter.ReaderFunc, W]](streamUseCase, cmdOpts...)
+	return interactiveCommand.Run(args)
+}
+
+// locales instantiates the list-locales use case and command and runs it.
+// It needs no writer: the listing goes to stdout, not through the port.
+func locales(args []string, cmdOpts []command.CommandOption) int {
+	localesUseCase := usecase.NewLocalesUseCase()
+	localesCommand := command.NewLocalesCommand[*usecase.LocalesUseCase](localesUseCase, cmdOpts...)
+	return localesCommand.Run(args)
+}
+
+// completion runs the completion command over the names of subs.
+func completion(subs []subcommand, args []string, cmdOpts []command.CommandOption) int {
+	names := make([]string, len(subs))
+	for i, sub := range subs {
+		names[i] = sub.name
+	}
+	return command.NewCompletionCommand(names, cmdOpts...).Run(args)
+}
@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package cli
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+)
+
+// recordingWriter is a WriterPort test double standing in for the real writer.
+type recordingWriter struct {
+	messages []string
+}
+
+func (w *recordingWriter) Write(_ context.Context, message string) domerr.Result[model.Unit] {
+	w.messages = append(w.messages, message)
+	return domerr.Ok(model.UnitValue)
+}
+
+// TestBootstrapCLIDryRun tests that --dry-run never reaches the real writer.
+func TestBootstrapCLIDryRun(t *testing.T) {
+	tf := test.New("Bootstrap.CLI.DryRun")
+
+	// ========================================================================
+	// Test: Normal mode uses the real writer
+	// ========================================================================
+
+	writer := &recordingWriter{}
+	code := run(writer, "test", []string{"greeter", "Alice"})
+	tf.AssertEqual("Normal - exit code 0", code, 0)
+	tf.AssertEqual("Normal - real writer received greeting",
+		writer.messages, []string{"Hello, Alice!"})
+
+	// ========================================================================
+	// Test: Dry-run never calls the real writer
+	// ========================================================================
+
+	writer = &recordingWriter{}
+	code = run(writer, "test", []string{"greeter", "--dry-run", "Alice"})
+	tf.AssertEqual("Dry-run - exit code 0", code, 0)
+	tf.AssertEqual("Dry-run - real writer never called", len(writer.messages), 0)
+
+	// ========================================================================
+	// Test: Dry-run still validates the name
+	// ========================================================================
+
+	// Validation errors exit with 2 (invalid input), see command.ExitCodeFor
+	writer = &recordingWriter{}
+	code = run(writer, "test", []string{"greeter", "--dry-run", strings.Repeat("x", 101)})
+	tf.AssertEqual("Dry-run invalid name - exit code 2", code, 2)
+	tf.AssertEqual("Dry-run invalid name - real writer never called", len(writer.messages), 0)
+
+	code = run(writer, "test", []string{"greeter", "--dry-run", ""})
+	tf.AssertEqual("Dry-run empty name - exit code 2", code, 2)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
+
+// TestBootstrapCLIAffix tests that --prefix/--suffix decorate the greeting.
+func TestBootstrapCLIAffix(t *testing.T) {
+	tf := test.New("Bootstrap.CLI.Affix")
+
+	// ========================================================================
+	// Test: Prefix and suffix wrap the greeting
+	// ========================================================================
+
+	writer := &recordingWriter{}
+	code := run(writer, "test", []string{"greeter", "--prefix", "[greeting] ", "--suffix", " [/greeting]", "Alice"})
+	tf.AssertEqual("Affix - exit code 0", code, 0)
+	tf.AssertEqual("Affix - decorated greeting",
+		writer.messages, []string{"[greeting] Hello, Alice! [/greeting]"})
+
+	// ========================================================================
+	// Test: Empty prefix/suffix reproduces current behaviour
+	// ========================================================================
+
+	writer = &recordingWriter{}
+	code = run(writer, "test", []string{"greeter", "--prefix", "", "--suffix", "", "Alice"})
+	tf.AssertEqual("Empty affix - exit code 0", code, 0)
+	tf.AssertEqual("Empty affix - plain greeting", writer.messages, []string{"Hello, Alice!"})
+
+	// ========================================================================
+	// Test: Prefix with dry-run still writes nothing
+	// ========================================================================
+
+	writer = &recordingWriter{}
+	code = run(writer, "test", []string{"greeter", "--dry-run", "--prefix", ">> ", "Alice"})
+	tf.AssertEqual("Affix dry-run - exit code 0", code, 0)
+	tf.AssertEqual("Affix dry-run - writer not called", len(writer.messages), 0)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
+
+// TestBootstrapCLIMainExit tests that Main exits through the injectable
+// exitFunc with Run's code.
+func TestBootstrapCLIMainExit(t *testing.T) {
+	tf := test.New("Bootstrap.CLI.MainExit")
+
+	var codes []int
+	saved := exitFunc
+	exitFunc = func(code int) { codes = append(codes, code) }
+	t.Cleanup(func() { exitFunc = saved })
+
+	// ========================================================================
+	// Test: Failing invocation - code captured, process not exited
+	// ========================================================================
+
+	// An empty name is invalid input: exit code 2 (see command.ExitCodeFor)
+	Main([]string{"greeter", "-q", ""})
+	tf.AssertEqual("Invalid name - exit called once with 2", codes, []int{2})
+
+	// ========================================================================
+	// Test: Invalid environment - terminal path also uses exitFunc
+	// ========================================================================
+
+	codes = nil
+	t.Setenv(EnvFormat, "xml")
+	Main([]string{"greeter", "Alice"})
+	tf.AssertEqual("Invalid environment - exit called once with 1", codes, []int{1})
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
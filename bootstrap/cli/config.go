@@ -0,0 +1,294 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: cli
+// Description: Composition root configuration for embedders
+
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	"github.com/abitofhelp/hybrid_app_go/presentation/adapter/cli/command"
+)
+
+// Environment variables read by ConfigFromEnv.
+const (
+	EnvFormat  = "GREETER_FORMAT"  // text | csv
+	EnvTimeout = "GREETER_TIMEOUT" // Go duration, e.g. "2s"
+	EnvOutput  = "GREETER_OUTPUT"  // stdout | stderr
+)
+
+// Output formats accepted by Config.Format.
+const (
+	// FormatText writes one plain line per greeting (default).
+	FormatText = "text"
+	// FormatCSV writes one quoted CSV row per greeting.
+	FormatCSV = "csv"
+)
+
+// Config holds the settings the composition root uses to wire the app.
+//
+// The zero value is not meant to be used directly; start from NewConfig
+// so defaults (stdout, text) are filled in.
+type Config struct {
+	// Output receives greetings and listings such as locales (default os.Stdout).
+	Output io.Writer
+
+	// Format selects the output encoding: FormatText or FormatCSV.
+	Format string
+
+	// Timeout bounds each use case call; 0 means no timeout.
+	Timeout time.Duration
+
+	// Input supplies names in --interactive mode (default os.Stdin).
+	Input io.Reader
+
+	// ErrOutput receives error reports, usage, and diagnostics (default
+	// os.Stderr). Unlike Output, it is never flushed or closed.
+	ErrOutput io.Writer
+}
+
+// Option configures a Config.
+type Option func(*Config)
+
+// WithOutput sends greetings to w instead of stdout.
+func WithOutput(w io.Writer) Option {
+	return func(c *Config) {
+		c.Output = w
+	}
+}
+
+// WithErrorOutput sends error reports, usage, and diagnostics to w
+// instead of stderr.
+func WithErrorOutput(w io.Writer) Option {
+	return func(c *Config) {
+		c.ErrOutput = w
+	}
+}
+
+// WithInput reads --interactive names from r instead of stdin.
+func WithInput(r io.Reader) Option {
+	return func(c *Config) {
+		c.Input = r
+	}
+}
+
+// WithFormat selects the output format (FormatText or FormatCSV).
+func WithFormat(format string) Option {
+	return func(c *Config) {
+		c.Format = format
+	}
+}
+
+// WithTimeout bounds each use case call by d (0 disables the timeout).
+func WithTimeout(d time.Duration) Option {
+	return func(c *Config) {
+		c.Timeout = d
+	}
+}
+
+// NewConfig returns the default configuration with opts applied.
+//
+// Defaults: Output os.Stdout, Input os.Stdin, ErrOutput os.Stderr, Format
+// FormatText, no Timeout.
+func NewConfig(opts ...Option) Config {
+	cfg := Config{Output: os.Stdout, Input: os.Stdin, ErrOutput: os.Stderr, Format: FormatText}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// ConfigFromEnv returns base with any GREETER_* environment variables applied.
+// Unset or empty variables leave base unchanged.
+//
+// A variable only replaces a default: a base value chosen by the caller
+// (a Format other than ""/FormatText, a non-zero Timeout, an Output other
+// than nil/os.Stdout) is kept, so an embedder's settings win over the
+// environment.
+//
+// Contract:
+//   - Post: Returns an error naming the variable if a value is invalid
+//     (unknown format or output target, unparsable or negative timeout)
+func ConfigFromEnv(base Config) (Config, error) {
+	cfg := base
+	if v := os.Getenv(EnvFormat); v != "" {
+		if !validFormat(v) {
+			return base, fmt.Errorf("%s: unknown format %q (want %s or %s)", EnvFormat, v, FormatText, FormatCSV)
+		}
+		if base.Format == "" || base.Format == FormatText {
+			cfg.Format = v
+		}
+	}
+	if v := os.Getenv(EnvTimeout); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			return base, fmt.Errorf("%s: invalid timeout %q (want a non-negative duration such as 2s)", EnvTimeout, v)
+		}
+		if base.Timeout == 0 {
+			cfg.Timeout = d
+		}
+	}
+	if v := os.Getenv(EnvOutput); v != "" {
+		w, err := outputTarget(v)
+		if err != nil {
+			return base, fmt.Errorf("%s: %w", EnvOutput, err)
+		}
+		if base.Output == nil || base.Output == os.Stdout {
+			cfg.Output = w
+		}
+	}
+	return cfg, nil
+}
+
+// applyFlags overrides cfg with wiring flags (--format, --output,
+// --timeout) given to greet, as "greeter greet [flags] <name>" or the
+// legacy "greeter [flags] <name>". Other subcommands take no wiring flags.
+//
+// Contract:
+//   - Post: Returns cfg unchanged and the parse error if the greet
+//     arguments do not parse (command.IsUsageError reports true)
+//   - Post: Returns an apperr.ErrorType (UNSUPPORTED_OPTION, from
+//     command.ParseEnum) if --format or --output names an unknown value
+func applyFlags(cfg Config, args []string) (Config, error) {
+	greetArgs, ok := wiringArgs(args)
+	if !ok {
+		return cfg, nil
+	}
+	opts, err := command.ParseOptions(greetArgs)
+	if err != nil {
+		return cfg, err
+	}
+	if opts.Format != "" {
+		format := command.ParseEnum(opts.Format, []string{FormatText, FormatCSV})
+		if format.IsError() {
+			return cfg, format.ErrorInfo()
+		}
+		cfg.Format = format.Value()
+	}
+	if opts.Timeout != 0 {
+		cfg.Timeout = opts.Timeout
+	}
+	if opts.Output != "" {
+		target := command.ParseEnum(opts.Output, outputTargets)
+		if target.IsError() {
+			return cfg, target.ErrorInfo()
+		}
+		w, err := outputTarget(target.Value())
+		if err != nil {
+			return cfg, fmt.Errorf("--output: %w", err)
+		}
+		cfg.Output = w
+	}
+	return cfg, nil
+}
+
+// wiringArgs returns the greet arguments that may carry wiring flags, with
+// a leading "greet" subcommand folded into the program name as dispatch
+// does. It reports false for the other subcommands and for no arguments.
+func wiringArgs(args []string) ([]string, bool) {
+	if len(args) < 2 {
+		return nil, false
+	}
+	switch args[1] {
+	case subGreet:
+		return append([]string{args[0] + " " + subGreet}, args[2:]...), true
+	case subGreetAll, subLocales, subCompletion:
+		return nil, false
+	default:
+		return args, true
+	}
+}
+
+// validFormat reports whether format is a supported output format.
+func validFormat(format string) bool {
+	return format == FormatText || format == FormatCSV
+}
+
+// outputTargets are the values accepted by --output and GREETER_OUTPUT.
+var outputTargets = []string{"stdout", "stderr"}
+
+// outputTarget maps "stdout"/"stderr" to the corresponding file.
+func outputTarget(name string) (io.Writer, error) {
+	switch name {
+	case "stdout":
+		return os.Stdout, nil
+	case "stderr":
+		return os.Stderr, nil
+	default:
+		return nil, fmt.Errorf("unknown output target %q (want stdout or stderr)", name)
+	}
+}
+
+// RunWith wires the application from cfg and runs it with args.
+//
+// This is the entry point for embedders that need a custom sink or
+// settings; Run is RunWith(ConfigFromEnv(NewConfig()), args).
+//
+// Precedence (lowest to highest): defaults, environment (via
+// ConfigFromEnv), cfg as given by the embedder, command-line flags
+// (--format, --output, --timeout).
+//
+// Contract:
+//   - Pre: args is os.Args-shaped (program name + arguments)
+//   - Post: Returns the command's exit code
+//   - Post: Returns 1 with a message on cfg.ErrOutput (default os.Stderr)
+//     if cfg is invalid
+//   - Post: Returns 2 with an UNSUPPORTED_OPTION error on cfg.ErrOutput if
+//     --format or --output names an unknown value
+//   - Post: cfg.Output is flushed and closed afterwards (see App); if that
+//     fails, an otherwise successful run returns 1
+func RunWith(cfg Config, args []string) int {
+	return RunContext(context.Background(), cfg, args)
+}
+
+// RunContext is RunWith with ctx as the parent of every use case call, so
+// an embedder can cancel the run or bound it with a deadline.
+//
+// Contract:
+//   - Same as RunWith
+//   - Post: If ctx is already done, the use case fails with an
+//     InfrastructureError before writing: exit code 1, no output
+func RunContext(ctx context.Context, cfg Config, args []string) int {
+	errOut := cfg.ErrOutput
+	if errOut == nil {
+		errOut = os.Stderr
+	}
+	// A usage error is left to the command, which reports it together
+	// with its usage text; an unsupported flag value is reported like the
+	// command's own validation errors; any other flag error stops here
+	cfg, err := applyFlags(cfg, args)
+	var invalid apperr.ErrorType
+	switch {
+	case err == nil, command.IsUsageError(err):
+	case errors.As(err, &invalid):
+		return command.RunResult(apperr.Err[model.Unit](invalid), errOut)
+	default:
+		fmt.Fprintf(errOut, "Error: %v\n", err)
+		return command.ExitFailure
+	}
+
+	// Lifecycle: start (validate), run the command, stop (flush/close the
+	// output); see App for embedders that manage these steps themselves
+	app := New(cfg)
+	if err := app.Start(ctx); err != nil {
+		fmt.Fprintf(errOut, "Error: %v\n", err)
+		app.Stop(context.Background())
+		return command.ExitFailure
+	}
+	code := app.Run(args)
+	if err := app.Stop(context.Background()); err != nil {
+		fmt.Fprintf(errOut, "Error: %v\n", err)
+		if code == command.ExitSuccess {
+			code = command.ExitFailure
+		}
+	}
+	return code
+}
@@ -0,0 +1,193 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package cli
+
+import (
+	"bytes"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/presentation/adapter/cli/command"
+)
+
+// TestBootstrapCLIConfig tests RunWith and the functional options.
+func TestBootstrapCLIConfig(t *testing.T) {
+	tf := test.New("Bootstrap.CLI.Config")
+
+	// ========================================================================
+	// Test: Defaults
+	// ========================================================================
+
+	cfg := NewConfig()
+	tf.RunTest("Default - output is stdout", cfg.Output == os.Stdout)
+	tf.AssertEqual("Default - text format", cfg.Format, FormatText)
+	tf.AssertEqual("Default - no timeout", cfg.Timeout, time.Duration(0))
+
+	// ========================================================================
+	// Test: Custom output captures the greeting
+	// ========================================================================
+
+	var buf bytes.Buffer
+	code := RunWith(NewConfig(WithOutput(&buf)), []string{"greeter", "Alice"})
+	tf.AssertEqual("Buffer - exit code 0", code, 0)
+	tf.AssertEqual("Buffer - captured output", buf.String(), "Hello, Alice!\n")
+
+	// ========================================================================
+	// Test: CSV format
+	// ========================================================================
+
+	buf.Reset()
+	code = RunWith(NewConfig(WithOutput(&buf), WithFormat(FormatCSV)), []string{"greeter", "O'Brien, Jr."})
+	tf.AssertEqual("CSV - exit code 0", code, 0)
+	tf.AssertEqual("CSV - quoted row", buf.String(), "\"Hello, O'Brien, Jr.!\"\n")
+
+	// ========================================================================
+	// Test: Timeout is accepted and generous timeouts still succeed
+	// ========================================================================
+
+	buf.Reset()
+	code = RunWith(NewConfig(WithOutput(&buf), WithTimeout(time.Minute)), []string{"greeter", "Alice"})
+	tf.AssertEqual("Timeout - exit code 0", code, 0)
+	tf.AssertEqual("Timeout - output", buf.String(), "Hello, Alice!\n")
+
+	// ========================================================================
+	// Test: Invalid configuration
+	// ========================================================================
+
+	buf.Reset()
+	code = RunWith(NewConfig(WithOutput(&buf), WithFormat("xml")), []string{"greeter", "Alice"})
+	tf.AssertEqual("Unknown format - exit code 1", code, 1)
+	tf.AssertEqual("Unknown format - nothing written", buf.Len(), 0)
+
+	code = RunWith(NewConfig(WithOutput(&buf), WithTimeout(-time.Second)), []string{"greeter", "Alice"})
+	tf.AssertEqual("Negative timeout - exit code 1", code, 1)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
+
+// TestBootstrapCLIConfigEnv tests GREETER_* environment configuration.
+func TestBootstrapCLIConfigEnv(t *testing.T) {
+	tf := test.New("Bootstrap.CLI.ConfigEnv")
+
+	// ========================================================================
+	// Test: Unset variables keep defaults
+	// ========================================================================
+
+	t.Setenv(EnvFormat, "")
+	t.Setenv(EnvTimeout, "")
+	t.Setenv(EnvOutput, "")
+	cfg, err := ConfigFromEnv(NewConfig())
+	tf.RunTest("Unset - no error", err == nil)
+	tf.AssertEqual("Unset - default format", cfg.Format, FormatText)
+	tf.RunTest("Unset - default output", cfg.Output == os.Stdout)
+
+	// ========================================================================
+	// Test: Env values are applied
+	// ========================================================================
+
+	t.Setenv(EnvFormat, "csv")
+	t.Setenv(EnvTimeout, "3s")
+	t.Setenv(EnvOutput, "stderr")
+	cfg, err = ConfigFromEnv(NewConfig())
+	tf.RunTest("Env - no error", err == nil)
+	tf.AssertEqual("Env - format", cfg.Format, FormatCSV)
+	tf.AssertEqual("Env - timeout", cfg.Timeout, 3*time.Second)
+	tf.RunTest("Env - output is stderr", cfg.Output == os.Stderr)
+
+	// ========================================================================
+	// Test: Explicit output wins over GREETER_OUTPUT
+	// ========================================================================
+
+	var explicit bytes.Buffer
+	cfg, err = ConfigFromEnv(NewConfig(WithOutput(&explicit)))
+	tf.RunTest("Explicit output - no error", err == nil)
+	tf.RunTest("Explicit output - kept", cfg.Output == &explicit)
+	tf.AssertEqual("Explicit output - other env still applied", cfg.Format, FormatCSV)
+
+	cfg, err = ConfigFromEnv(NewConfig(WithFormat(FormatCSV), WithTimeout(time.Second)))
+	tf.RunTest("Explicit format and timeout - no error", err == nil)
+	tf.AssertEqual("Explicit timeout - kept", cfg.Timeout, time.Second)
+
+	t.Setenv(EnvFormat, "text")
+	cfg, _ = ConfigFromEnv(NewConfig(WithFormat(FormatCSV)))
+	tf.AssertEqual("Explicit format - kept", cfg.Format, FormatCSV)
+	t.Setenv(EnvFormat, "csv")
+
+	cfg, err = ConfigFromEnv(NewConfig(WithOutput(os.Stdout)))
+	tf.RunTest("Stdout output - replaced by env", err == nil && cfg.Output == os.Stderr)
+
+	cfg, err = ConfigFromEnv(Config{})
+	tf.RunTest("Nil output - replaced by env", err == nil && cfg.Output == os.Stderr)
+
+	// ========================================================================
+	// Test: Flags override env
+	// ========================================================================
+
+	t.Setenv(EnvOutput, "")
+	var buf bytes.Buffer
+	cfg, _ = ConfigFromEnv(NewConfig(WithOutput(&buf)))
+	code := RunWith(cfg, []string{"greeter", "O'Brien, Jr."})
+	tf.AssertEqual("Env csv - exit code 0", code, 0)
+	tf.AssertEqual("Env csv - quoted row", buf.String(), "\"Hello, O'Brien, Jr.!\"\n")
+
+	buf.Reset()
+	code = RunWith(cfg, []string{"greeter", "--format", "text", "O'Brien, Jr."})
+	tf.AssertEqual("Flag wins - exit code 0", code, 0)
+	tf.AssertEqual("Flag wins - plain text", buf.String(), "Hello, O'Brien, Jr.!\n")
+
+	buf.Reset()
+	code = RunWith(cfg, []string{"greeter", "--format", "CSV", "O'Brien, Jr."})
+	tf.AssertEqual("Flag case-insensitive - exit code 0", code, 0)
+	tf.AssertEqual("Flag case-insensitive - quoted row", buf.String(), "\"Hello, O'Brien, Jr.!\"\n")
+
+	// ========================================================================
+	// Test: Unsupported wiring flag values are UNSUPPORTED_OPTION (exit 2)
+	// ========================================================================
+
+	for _, tc := range []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"format", []string{"greeter", "--format", "xml", "Alice"},
+			`Error: UNSUPPORTED_OPTION: unsupported value "xml" (valid: text, csv)`},
+		{"greet format", []string{"greeter", "greet", "--format=xml", "Alice"},
+			`Error: UNSUPPORTED_OPTION: unsupported value "xml" (valid: text, csv)`},
+		{"output", []string{"greeter", "--output=printer", "Alice"},
+			`Error: UNSUPPORTED_OPTION: unsupported value "printer" (valid: stdout, stderr)`},
+	} {
+		var out, errOut bytes.Buffer
+		code = RunWith(NewConfig(WithOutput(&out), WithErrorOutput(&errOut)), tc.args)
+		tf.AssertEqual("Invalid "+tc.name+" flag - exit code 2", code, command.ExitInvalidInput)
+		tf.AssertEqual("Invalid "+tc.name+" flag - nothing written", out.Len(), 0)
+		tf.AssertEqual("Invalid "+tc.name+" flag - reported on ErrOutput",
+			strings.SplitN(errOut.String(), "\n", 2)[0], tc.want)
+	}
+
+	// ========================================================================
+	// Test: Invalid env values are startup errors
+	// ========================================================================
+
+	for _, tc := range []struct{ key, value string }{
+		{EnvFormat, "xml"},
+		{EnvTimeout, "soon"},
+		{EnvTimeout, "-1s"},
+		{EnvOutput, "printer"},
+	} {
+		t.Setenv(EnvFormat, "")
+		t.Setenv(EnvTimeout, "")
+		t.Setenv(EnvOutput, "")
+		t.Setenv(tc.key, tc.value)
+		_, err = ConfigFromEnv(NewConfig())
+		tf.RunTest("Invalid "+tc.key+"="+tc.value+" - error", err != nil)
+		tf.AssertEqual("Invalid "+tc.key+"="+tc.value+" - Run exits 1", Run([]string{"greeter", "Alice"}), 1)
+	}
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
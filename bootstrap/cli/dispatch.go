@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: cli
+// Description: Subcommand dispatch for the CLI composition root
+
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/abitofhelp/hybrid_app_go/application/port/outbound"
+	"github.com/abitofhelp/hybrid_app_go/infrastructure/adapter"
+	"github.com/abitofhelp/hybrid_app_go/presentation/adapter/cli/command"
+)
+
+// Subcommand names, shared by dispatch and applyFlags.
+const (
+	subGreet      = "greet"
+	subGreetAll   = "greet-all"
+	subLocales    = "locales"
+	subCompletion = "completion"
+)
+
+// subcommand is a named entry point wired by the composition root.
+type subcommand struct {
+	name    string
+	summary string
+	run     func(args []string) int
+}
+
+// subcommands returns the known subcommands, in help order, wired to writer.
+//
+// To add a subcommand (e.g., farewell), append an entry whose run function
+// instantiates its use case and command the same way greet does.
+func subcommands[W outbound.WriterPort](writer W, writerName string, reader adapter.ReaderFunc, cmdOpts []command.CommandOption) []subcommand {
+	subs := []subcommand{
+		{
+			name:    subGreet,
+			summary: "Greet a person by name",
+			run:     func(args []string) int { return greet(writer, writerName, reader, args, cmdOpts) },
+		},
+		{
+			name:    subGreetAll,
+			summary: "Greet several names, skipping invalid ones",
+			run:     func(args []string) int { return runGreetAll(writer, args, cmdOpts) },
+		},
+		{
+			name:    subLocales,
+			summary: "List supported locales with sample greetings",
+			run:     func(args []string) int { return locales(args, cmdOpts) },
+		},
+	}
+	// completion lists every subcommand, itself included, so it is added
+	// last and reads subs only when it runs
+	subs = append(subs, subcommand{
+		name:    subCompletion,
+		summary: "Print a shell completion script (bash, zsh, fish)",
+		run:     func(args []string) int { return completion(subs, args, cmdOpts) },
+	})
+	return subs
+}
+
+// run dispatches to a subcommand based on args[1].
+//
+// Routing:
+//   - greeter                  → print available commands to the
+//     configured error output (exit 1)
+//   - greeter <command> [...]  → run <command> with the remaining args
+//   - greeter [flags] <name>   → legacy form, handled by greet
+//
+// The legacy fallback applies whenever args[1] is not a known subcommand,
+// so an unknown word followed by a name is reported as a greet usage error.
+// writerName describes writer for --verbose diagnostics; cmdOpts are
+// passed to every command (e.g., the timeout from Config).
+// --interactive reads names from stdin; see runWithInput.
+func run[W outbound.WriterPort](writer W, writerName string, args []string, cmdOpts ...command.CommandOption) int {
+	return runWithInput(writer, writerName, adapter.NewLimitedLineReader(os.Stdin, interactiveMaxLineBytes), args, cmdOpts...)
+}
+
+// runWithInput is run with reader supplying names in --interactive mode.
+func runWithInput[W outbound.WriterPort](writer W, writerName string, reader adapter.ReaderFunc, args []string, cmdOpts ...command.CommandOption) int {
+	programName := "greeter"
+	if len(args) > 0 {
+		programName = args[0]
+	}
+
+	if len(args) < 2 {
+		printCommands(command.ErrorOutput(cmdOpts...), programName, subcommands(writer, writerName, reader, cmdOpts))
+		return command.ExitFailure
+	}
+
+	for _, sub := range subcommands(writer, writerName, reader, cmdOpts) {
+		if args[1] == sub.name {
+			// Subcommand sees "<program> <name>" as its program name for usage
+			subArgs := append([]string{programName + " " + sub.name}, args[2:]...)
+			return sub.run(subArgs)
+		}
+	}
+
+	// Legacy: greeter [flags] <name>
+	return greet(writer, writerName, reader, args, cmdOpts)
+}
+
+// printCommands writes the top-level usage and the list of subcommands to w.
+func printCommands(w io.Writer, programName string, subs []subcommand) {
+	fmt.Fprintf(w, "Usage: %s <command> [flags] <name>\n", programName)
+	fmt.Fprintf(w, "       %s [flags] <name>   (same as: %s greet)\n", programName, programName)
+	fmt.Fprintln(w, "Commands:")
+	for _, sub := range subs {
+		fmt.Fprintf(w, "  %-10s %s\n", sub.name, sub.summary)
+	}
+}
@@ -0,0 +1,219 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package cli
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/infrastructure/adapter"
+	"github.com/abitofhelp/hybrid_app_go/presentation/adapter/cli/command"
+)
+
+// TestBootstrapCLIDispatch tests subcommand routing and the legacy fallback.
+func TestBootstrapCLIDispatch(t *testing.T) {
+	tf := test.New("Bootstrap.CLI.Dispatch")
+
+	// ========================================================================
+	// Test: greet subcommand
+	// ========================================================================
+
+	writer := &recordingWriter{}
+	code := run(writer, "test", []string{"greeter", "greet", "Alice"})
+	tf.AssertEqual("greet subcommand - exit code 0", code, 0)
+	tf.AssertEqual("greet subcommand - greeting written",
+		writer.messages, []string{"Hello, Alice!"})
+
+	writer = &recordingWriter{}
+	code = run(writer, "test", []string{"greeter", "greet", "--dry-run", "Alice"})
+	tf.AssertEqual("greet subcommand with flag - exit code 0", code, 0)
+	tf.AssertEqual("greet subcommand with flag - writer not called", len(writer.messages), 0)
+
+	// ========================================================================
+	// Test: Wiring flags after the greet subcommand are applied
+	// ========================================================================
+
+	var csvOut bytes.Buffer
+	code = RunWith(NewConfig(WithOutput(&csvOut)),
+		[]string{"greeter", "greet", "--format", "csv", "O'Brien, Jr."})
+	tf.AssertEqual("greet --format csv - exit code 0", code, 0)
+	tf.AssertEqual("greet --format csv - quoted row", csvOut.String(), "\"Hello, O'Brien, Jr.!\"\n")
+
+	wired, err := applyFlags(NewConfig(WithOutput(&bytes.Buffer{})),
+		[]string{"greeter", "greet", "--output", "stderr", "--timeout=2s", "Alice"})
+	tf.RunTest("greet --output stderr - no error", err == nil)
+	tf.RunTest("greet --output stderr - output is stderr", wired.Output == os.Stderr)
+	tf.AssertEqual("greet --timeout - applied", wired.Timeout, 2*time.Second)
+
+	_, err = applyFlags(NewConfig(), []string{"greeter", "greet", "--nope", "Alice"})
+	tf.RunTest("greet bad flag - usage error returned", command.IsUsageError(err))
+
+	wired, err = applyFlags(NewConfig(), []string{"greeter", "locales"})
+	tf.RunTest("locales - no wiring flags", err == nil && wired.Output == os.Stdout)
+
+	// ========================================================================
+	// Test: greet-all subcommand (partial success)
+	// ========================================================================
+
+	writer = &recordingWriter{}
+	code = run(writer, "test", []string{"greeter", "greet-all", "Alice", "Bob"}, command.WithErrorOutput(io.Discard))
+	tf.AssertEqual("greet-all, all valid - exit code 0", code, 0)
+	tf.AssertEqual("greet-all, all valid - greetings written",
+		writer.messages, []string{"Hello, Alice!", "Hello, Bob!"})
+
+	writer = &recordingWriter{}
+	var summary bytes.Buffer
+	code = run(writer, "test", []string{"greeter", "greet-all", "Alice", "", "Bob"}, command.WithErrorOutput(&summary))
+	tf.AssertEqual("greet-all, mixed - exit code 3", code, 3)
+	tf.AssertEqual("greet-all, mixed - valid names written",
+		writer.messages, []string{"Hello, Alice!", "Hello, Bob!"})
+	tf.AssertEqual("greet-all, mixed - summary",
+		summary.String(), "Greeted 2 of 3 names; skipped 1:\n  names[1]: Person name cannot be empty\n")
+
+	writer = &recordingWriter{}
+	code = run(writer, "test", []string{"greeter", "greet-all", "", ""}, command.WithErrorOutput(io.Discard))
+	tf.AssertEqual("greet-all, all invalid - exit code 2", code, 2)
+	tf.AssertEqual("greet-all, all invalid - nothing written", len(writer.messages), 0)
+
+	// ========================================================================
+	// Test: --max-output-bytes aborts once the encoded budget is exhausted
+	// ========================================================================
+
+	// Each "Hello, <name>!" line below costs 14 bytes with its newline
+	runLimited := func(args ...string) (int, string) {
+		var out bytes.Buffer
+		code := RunWith(NewConfig(WithOutput(&out), WithErrorOutput(io.Discard)), append([]string{"greeter"}, args...))
+		return code, out.String()
+	}
+	code, out := runLimited("greet-all", "--max-output-bytes=28", "Alice", "Bobby", "Carol")
+	tf.AssertEqual("greet-all, over budget - exit code 1", code, 1)
+	tf.AssertEqual("greet-all, over budget - writes within budget kept", out, "Hello, Alice!\nHello, Bobby!\n")
+
+	code, _ = runLimited("greet-all", "--max-output-bytes=28", "Alice", "Bobby")
+	tf.AssertEqual("greet-all, exactly at budget - exit code 0", code, 0)
+
+	code, out = runLimited("greet", "--count=5", "--max-output-bytes=28", "Alice")
+	tf.AssertEqual("greet --count, over budget - exit code 1", code, 1)
+	tf.AssertEqual("greet --count, over budget - stops at budget", out, "Hello, Alice!\nHello, Alice!\n")
+
+	// The quoted CSV row is 23 bytes though the greeting plus newline is 21
+	code, out = runLimited("--format", "csv", "--max-output-bytes=21", "O'Brien, Jr.")
+	tf.AssertEqual("csv, quoting over budget - exit code 1", code, 1)
+	tf.AssertEqual("csv, quoting over budget - nothing written", out, "")
+
+	code, out = runLimited("--format", "csv", "--max-output-bytes=23", "O'Brien, Jr.")
+	tf.AssertEqual("csv, exactly at budget - exit code 0", code, 0)
+	tf.AssertEqual("csv, exactly at budget - quoted row", out, "\"Hello, O'Brien, Jr.!\"\n")
+
+	// ========================================================================
+	// Test: --interactive greets names from the injected input
+	// ========================================================================
+
+	writer = &recordingWriter{}
+	input := adapter.NewLimitedLineReader(strings.NewReader("Alice\nBob\n"), interactiveMaxLineBytes)
+	code = runWithInput(writer, "test", input, []string{"greeter", "--interactive"}, command.WithErrorOutput(io.Discard))
+	tf.AssertEqual("interactive, EOF - exit code 0", code, 0)
+	tf.AssertEqual("interactive, EOF - greetings written",
+		writer.messages, []string{"Hello, Alice!", "Hello, Bob!"})
+
+	writer = &recordingWriter{}
+	input = adapter.NewLimitedLineReader(strings.NewReader("Alice\n\nBob\n"), interactiveMaxLineBytes)
+	code = runWithInput(writer, "test", input, []string{"greeter", "greet", "--interactive"}, command.WithErrorOutput(io.Discard))
+	tf.AssertEqual("interactive, blank line - exit code 0", code, 0)
+	tf.AssertEqual("interactive, blank line - stops before Bob", writer.messages, []string{"Hello, Alice!"})
+
+	// Flags that shape a single greeting are rejected, not silently dropped
+	for _, flag := range []string{"--dry-run", "--prefix=> ", "--count=2", "--case=upper", "--max-output-bytes=64"} {
+		writer = &recordingWriter{}
+		input = adapter.NewLimitedLineReader(strings.NewReader("Alice\n"), interactiveMaxLineBytes)
+		code = runWithInput(writer, "test", input, []string{"greeter", "--interactive", flag}, command.WithErrorOutput(io.Discard))
+		tf.AssertEqual("interactive "+flag+" - exit code 1", code, 1)
+		tf.AssertEqual("interactive "+flag+" - nothing written", len(writer.messages), 0)
+	}
+
+	// ========================================================================
+	// Test: locales subcommand
+	// ========================================================================
+
+	writer = &recordingWriter{}
+	var listing bytes.Buffer
+	code = run(writer, "test", []string{"greeter", "locales"}, command.WithOutput(&listing))
+	tf.AssertEqual("locales - exit code 0", code, 0)
+	tf.AssertEqual("locales - writer not called", len(writer.messages), 0)
+	for _, line := range []string{"en  Hello, Alice!", "es  ¡Hola, Alice!", "fr  Bonjour, Alice !"} {
+		tf.RunTest("locales - lists "+line[:2], strings.Contains(listing.String(), line+"\n"))
+	}
+
+	// ========================================================================
+	// Test: completion subcommand
+	// ========================================================================
+
+	writer = &recordingWriter{}
+	var script bytes.Buffer
+	code = run(writer, "test", []string{"greeter", "completion", "bash"}, command.WithOutput(&script))
+	tf.AssertEqual("completion bash - exit code 0", code, 0)
+	tf.AssertEqual("completion bash - writer not called", len(writer.messages), 0)
+	tf.RunTest("completion bash - lists subcommands",
+		strings.Contains(script.String(), `compgen -W "greet greet-all locales completion"`))
+	tf.RunTest("completion bash - lists --format", strings.Contains(script.String(), " --format "))
+	tf.RunTest("completion bash - registers function",
+		strings.Contains(script.String(), "complete -F _greeter_completions greeter\n"))
+
+	code = run(writer, "test", []string{"greeter", "completion", "powershell"}, command.WithErrorOutput(io.Discard))
+	tf.AssertEqual("completion, unsupported shell - exit code 2", code, 2)
+
+	// ========================================================================
+	// Test: Legacy positional name
+	// ========================================================================
+
+	writer = &recordingWriter{}
+	code = run(writer, "test", []string{"greeter", "Bob"})
+	tf.AssertEqual("Legacy name - exit code 0", code, 0)
+	tf.AssertEqual("Legacy name - greeting written",
+		writer.messages, []string{"Hello, Bob!"})
+
+	// ========================================================================
+	// Test: Unknown subcommand falls through to greet usage error
+	// ========================================================================
+
+	writer = &recordingWriter{}
+	code = run(writer, "test", []string{"greeter", "frobnicate", "Alice"})
+	tf.AssertEqual("Unknown subcommand - exit code 1", code, 1)
+	tf.AssertEqual("Unknown subcommand - writer not called", len(writer.messages), 0)
+
+	// ========================================================================
+	// Test: No arguments lists available commands
+	// ========================================================================
+
+	writer = &recordingWriter{}
+	var usage bytes.Buffer
+	code = run(writer, "test", []string{"greeter"}, command.WithErrorOutput(&usage))
+	tf.AssertEqual("No args - exit code 1", code, 1)
+	tf.AssertEqual("No args - writer not called", len(writer.messages), 0)
+	tf.RunTest("No args - command list on the configured error output",
+		strings.HasPrefix(usage.String(), "Usage: greeter <command>"))
+
+	var stdout, stderr bytes.Buffer
+	code = RunWith(NewConfig(WithOutput(&stdout), WithErrorOutput(&stderr)), []string{"greeter"})
+	tf.AssertEqual("RunWith no args - exit code 1", code, 1)
+	tf.AssertEqual("RunWith no args - nothing on output", stdout.Len(), 0)
+	tf.RunTest("RunWith no args - command list on ErrOutput",
+		strings.Contains(stderr.String(), "Commands:") && strings.Contains(stderr.String(), "  greet-all "))
+
+	var buf bytes.Buffer
+	printCommands(&buf, "greeter", subcommands(writer, "test", nil, nil))
+	tf.RunTest("Command list - contains usage", strings.Contains(buf.String(), "Usage:"))
+	tf.RunTest("Command list - lists greet", strings.Contains(buf.String(), "  greet "))
+	tf.RunTest("Command list - lists greet-all", strings.Contains(buf.String(), "  greet-all "))
+	tf.RunTest("Command list - lists locales", strings.Contains(buf.String(), "  locales "))
+	tf.RunTest("Command list - lists completion", strings.Contains(buf.String(), "  completion "))
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
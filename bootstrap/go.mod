@@ -10,12 +10,11 @@ go 1.23
 
 require (
 	github.com/abitofhelp/hybrid_app_go/application v0.0.0
+	github.com/abitofhelp/hybrid_app_go/domain v0.0.0
 	github.com/abitofhelp/hybrid_app_go/infrastructure v0.0.0
 	github.com/abitofhelp/hybrid_app_go/presentation v0.0.0
 )
 
-require github.com/abitofhelp/hybrid_app_go/domain v0.0.0 // indirect
-
 replace (
 	github.com/abitofhelp/hybrid_app_go/application => ../application
 	github.com/abitofhelp/hybrid_app_go/domain => ../domain
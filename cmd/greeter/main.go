@@ -7,9 +7,9 @@
 // This is intentionally minimal - all logic lives in the Bootstrap layer.
 //
 // Architecture Notes:
-//   - Minimal entry point (3-line implementation)
-//   - Delegates to Bootstrap.Run for all logic
-//   - Only responsible for process exit code
+//   - Minimal entry point (1-line implementation)
+//   - Delegates to Bootstrap.Main for all logic, including the process
+//     exit code (so the exit path is testable in bootstrap)
 //   - No business logic here
 //
 // Usage:
@@ -25,9 +25,6 @@ import (
 )
 
 func main() {
-	// Delegate to Bootstrap layer for all logic
-	exitCode := cli.Run(os.Args)
-
-	// Set process exit code
-	os.Exit(exitCode)
+	// Delegate to Bootstrap layer for all logic and the process exit code
+	cli.Main(os.Args)
 }
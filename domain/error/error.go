@@ -28,7 +28,10 @@
 //	}
 package error
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+)
 
 // ErrorKind represents categories of errors that can occur in the application.
 // This enables pattern matching and different handling strategies per category.
@@ -45,6 +48,9 @@ const (
 
 	// InfrastructureError indicates infrastructure failures (I/O, network, DB)
 	InfrastructureError
+
+	// NotFoundError indicates a requested entity does not exist (lookups)
+	NotFoundError
 )
 
 // String returns a human-readable representation of the ErrorKind.
@@ -54,11 +60,48 @@ func (k ErrorKind) String() string {
 		return "ValidationError"
 	case InfrastructureError:
 		return "InfrastructureError"
+	case NotFoundError:
+		return "NotFoundError"
 	default:
 		return "UnknownError"
 	}
 }
 
+// ParseErrorKind is the inverse of ErrorKind.String: it maps a kind name
+// (e.g., "ValidationError", as received over the wire) back to the kind.
+//
+// Contract:
+//   - Post: ParseErrorKind(k.String()) == (k, true) for every known kind
+//   - Post: Returns false for any other string, including "UnknownError"
+//   - Post: Matching is exact (case-sensitive)
+func ParseErrorKind(s string) (ErrorKind, bool) {
+	switch s {
+	case ValidationError.String():
+		return ValidationError, true
+	case InfrastructureError.String():
+		return InfrastructureError, true
+	case NotFoundError.String():
+		return NotFoundError, true
+	default:
+		return 0, false
+	}
+}
+
+// Code returns a stable, machine-readable identifier for the ErrorKind
+// (e.g., "validation_error"), suitable for API clients to switch on.
+func (k ErrorKind) Code() string {
+	switch k {
+	case ValidationError:
+		return "validation_error"
+	case InfrastructureError:
+		return "infrastructure_error"
+	case NotFoundError:
+		return "not_found_error"
+	default:
+		return "unknown_error"
+	}
+}
+
 // ErrorType is the concrete error type used throughout the application.
 // It combines an error category (Kind) with a descriptive message.
 //
@@ -70,9 +113,14 @@ func (k ErrorKind) String() string {
 // Contract:
 //   - Message should be non-empty when creating errors
 //   - Kind should be a valid ErrorKind value
+//   - The debug stack (see WithStack) is never part of Error() or JSON
 type ErrorType struct {
 	Kind    ErrorKind
 	Message string
+
+	// stack is an optional goroutine stack trace for debugging, e.g.,
+	// where a recovered panic originated. Access it through Stack.
+	stack string
 }
 
 // Error implements the error interface for ErrorType.
@@ -81,6 +129,27 @@ func (e ErrorType) Error() string {
 	return fmt.Sprintf("%s: %s", e.Kind, e.Message)
 }
 
+// WithStack returns a copy of the error carrying stack (typically
+// runtime/debug.Stack() captured in a recover) for debug logging.
+// The user-facing Message is unchanged.
+func (e ErrorType) WithStack(stack []byte) ErrorType {
+	e.stack = string(stack)
+	return e
+}
+
+// Stack returns the debug stack trace attached with WithStack, or "" if
+// none. It is intended for logs, not for users or API clients.
+func (e ErrorType) Stack() string {
+	return e.stack
+}
+
+// Is reports whether target is an ErrorType with the same Kind and
+// Message, so errors.Is matches regardless of any attached stack.
+func (e ErrorType) Is(target error) bool {
+	t, ok := target.(ErrorType)
+	return ok && t.Kind == e.Kind && t.Message == e.Message
+}
+
 // NewValidationError creates a new validation error with the given message.
 func NewValidationError(message string) ErrorType {
 	return ErrorType{
@@ -96,3 +165,28 @@ func NewInfrastructureError(message string) ErrorType {
 		Message: message,
 	}
 }
+
+// NewNotFoundError creates a new not-found error with the given message.
+func NewNotFoundError(message string) ErrorType {
+	return ErrorType{
+		Kind:    NotFoundError,
+		Message: message,
+	}
+}
+
+// errorJSON is the wire shape of ErrorType.
+type errorJSON struct {
+	Kind    string `json:"kind"`
+	Message string `json:"message"`
+	Code    string `json:"code"`
+}
+
+// MarshalJSON encodes the error for HTTP/JSON adapters as
+//
+//	{"kind":"ValidationError","message":"...","code":"validation_error"}
+//
+// Kind uses ErrorKind.String and code uses ErrorKind.Code, so clients
+// never see the underlying integer values.
+func (e ErrorType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(errorJSON{Kind: e.Kind.String(), Message: e.Message, Code: e.Kind.Code()})
+}
@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package error_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+)
+
+// TestDomainErrorJSON tests the JSON wire shape of ErrorType.
+func TestDomainErrorJSON(t *testing.T) {
+	tf := test.New("Domain.Error.JSON")
+
+	// ========================================================================
+	// Test: Each kind serializes with string names, not integers
+	// ========================================================================
+
+	cases := []struct {
+		name string
+		err  domerr.ErrorType
+		want string
+	}{
+		{"ValidationError", domerr.NewValidationError("Person name cannot be empty"),
+			`{"kind":"ValidationError","message":"Person name cannot be empty","code":"validation_error"}`},
+		{"InfrastructureError", domerr.NewInfrastructureError("write failed: broken pipe"),
+			`{"kind":"InfrastructureError","message":"write failed: broken pipe","code":"infrastructure_error"}`},
+		{"NotFoundError", domerr.NewNotFoundError("no person named Bob"),
+			`{"kind":"NotFoundError","message":"no person named Bob","code":"not_found_error"}`},
+		{"Unknown kind", domerr.ErrorType{Kind: domerr.ErrorKind(99), Message: "?"},
+			`{"kind":"UnknownError","message":"?","code":"unknown_error"}`},
+	}
+	for _, tc := range cases {
+		got, err := json.Marshal(tc.err)
+		tf.RunTest(tc.name+" - marshals without error", err == nil)
+		tf.AssertEqual(tc.name+" - JSON shape", string(got), tc.want)
+	}
+
+	// ========================================================================
+	// Test: Message is escaped, and nesting uses the same shape
+	// ========================================================================
+
+	got, _ := json.Marshal(domerr.NewValidationError(`name "<x>" rejected`))
+	tf.AssertEqual("Escaping - quotes and HTML",
+		string(got), `{"kind":"ValidationError","message":"name \"\u003cx\u003e\" rejected","code":"validation_error"}`)
+
+	got, _ = json.Marshal(map[string]domerr.ErrorType{"error": domerr.NewValidationError("bad")})
+	tf.AssertEqual("Nested - same shape",
+		string(got), `{"error":{"kind":"ValidationError","message":"bad","code":"validation_error"}}`)
+
+	// ========================================================================
+	// Test: Debug stack is attached but never serialized
+	// ========================================================================
+
+	plain := domerr.NewInfrastructureError("write panicked: boom")
+	traced := plain.WithStack([]byte("goroutine 1 [running]:"))
+	tf.AssertEqual("Stack - default empty", plain.Stack(), "")
+	tf.AssertEqual("Stack - accessor returns trace", traced.Stack(), "goroutine 1 [running]:")
+	tf.AssertEqual("Stack - message unchanged", traced.Message, plain.Message)
+	tf.AssertEqual("Stack - Error() unchanged", traced.Error(), plain.Error())
+	got, _ = json.Marshal(traced)
+	tf.AssertEqual("Stack - omitted from JSON",
+		string(got), `{"kind":"InfrastructureError","message":"write panicked: boom","code":"infrastructure_error"}`)
+
+	// ========================================================================
+	// Test: Debug stack is not part of an error's identity
+	// ========================================================================
+
+	tf.RunTest("Stack - ResultsEqual ignores stack",
+		domerr.ResultsEqual(domerr.Err[int](traced), domerr.Err[int](plain)))
+	_, err := domerr.Err[int](traced).ToError()
+	tf.RunTest("Stack - errors.Is matches unstacked error", errors.Is(err, plain))
+	tf.RunTest("Stack - errors.Is matches through wrapping",
+		errors.Is(fmt.Errorf("greet: %w", err), plain.WithStack([]byte("other stack"))))
+	tf.RunTest("Stack - errors.Is still compares Kind",
+		!errors.Is(err, domerr.NewValidationError("write panicked: boom")))
+	tf.RunTest("Stack - errors.Is still compares Message",
+		!errors.Is(err, domerr.NewInfrastructureError("write failed")))
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
+
+// TestDomainErrorKindString tests the String/ParseErrorKind round-trip.
+func TestDomainErrorKindString(t *testing.T) {
+	tf := test.New("Domain.Error.KindString")
+
+	// ========================================================================
+	// Test: Every known kind round-trips through its name
+	// ========================================================================
+
+	for _, kind := range []domerr.ErrorKind{domerr.ValidationError, domerr.InfrastructureError, domerr.NotFoundError} {
+		parsed, ok := domerr.ParseErrorKind(kind.String())
+		tf.RunTest(kind.String()+" - parses", ok)
+		tf.AssertEqual(kind.String()+" - round-trips", parsed, kind)
+	}
+
+	// ========================================================================
+	// Test: Unknown names are rejected
+	// ========================================================================
+
+	for _, name := range []string{"", "UnknownError", "validationerror", "validation_error", "NotAKind"} {
+		_, ok := domerr.ParseErrorKind(name)
+		tf.RunTest("Unknown name "+`"`+name+`"`+" - rejected", !ok)
+	}
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
+
+// TestDomainErrorNotFound tests construction of NotFoundError values.
+func TestDomainErrorNotFound(t *testing.T) {
+	tf := test.New("Domain.Error.NotFound")
+
+	err := domerr.NewNotFoundError("no person named Bob")
+	tf.AssertEqual("NewNotFoundError - Kind", err.Kind, domerr.NotFoundError)
+	tf.AssertEqual("NewNotFoundError - Message", err.Message, "no person named Bob")
+	tf.AssertEqual("NewNotFoundError - Error()", err.Error(), "NotFoundError: no person named Bob")
+	tf.RunTest("NewNotFoundError - distinct from existing kinds",
+		err.Kind != domerr.ValidationError && err.Kind != domerr.InfrastructureError)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
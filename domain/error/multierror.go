@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: error
+// Description: Error aggregation for operations that report several failures
+
+package error
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Severity ranks an ErrorKind for aggregation: higher is more severe.
+//
+// Ordering: InfrastructureError (3) > NotFoundError (2) > ValidationError (1).
+// Unknown kinds rank 0, below every known kind.
+func (k ErrorKind) Severity() int {
+	switch k {
+	case InfrastructureError:
+		return 3
+	case NotFoundError:
+		return 2
+	case ValidationError:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// MultiError collects the errors of an operation that can fail several
+// times (e.g., a batch or a fan-out to multiple writers).
+//
+// Contract:
+//   - Order is preserved: errors render in the order they were collected
+//   - Implements error; Error() renders a numbered list
+type MultiError []ErrorType
+
+// Error renders the collected errors as a numbered list:
+//
+//	2 errors:
+//	1. ValidationError: Person name cannot be empty
+//	2. InfrastructureError: write failed: broken pipe
+func (m MultiError) Error() string {
+	var b strings.Builder
+	if len(m) == 1 {
+		b.WriteString("1 error:")
+	} else {
+		fmt.Fprintf(&b, "%d errors:", len(m))
+	}
+	for i, err := range m {
+		fmt.Fprintf(&b, "\n%d. %s", i+1, err.Error())
+	}
+	return b.String()
+}
+
+// Kind returns the most severe kind among the collected errors; ties go to
+// the earliest. An empty MultiError reports ValidationError.
+func (m MultiError) Kind() ErrorKind {
+	kind := ValidationError
+	for i, err := range m {
+		if i == 0 || err.Kind.Severity() > kind.Severity() {
+			kind = err.Kind
+		}
+	}
+	return kind
+}
+
+// NewMultiError aggregates errs into a single ErrorType so it can travel in
+// a Result like any other error.
+//
+// Contract:
+//   - Post: A single error is returned unchanged
+//   - Post: Otherwise Kind is MultiError(errs).Kind() (the most severe) and
+//     Message is the numbered list from MultiError(errs).Error()
+func NewMultiError(errs ...ErrorType) ErrorType {
+	if len(errs) == 1 {
+		return errs[0]
+	}
+	m := MultiError(errs)
+	return ErrorType{
+		Kind:    m.Kind(),
+		Message: m.Error(),
+	}
+}
+
+// CollectAll turns a slice of Results into a Result of their values, in
+// order. Unlike stopping at the first failure, it aggregates every error
+// (via NewMultiError), so a caller such as form validation can report all
+// problems at once.
+//
+// Contract:
+//   - Post: Returns Ok(values) if every Result is Ok (Ok of an empty,
+//     non-nil slice for no Results)
+//   - Post: Otherwise returns Err(NewMultiError(errs...)) with the errors in
+//     input order; a single error is returned unchanged
+//
+// Example:
+//
+//	fields := CollectAll([]Result[string]{checkName(n), checkEmail(e)})
+func CollectAll[T any](results []Result[T]) Result[[]T] {
+	values := make([]T, 0, len(results))
+	var errs []ErrorType
+	for _, r := range results {
+		if r.IsError() {
+			errs = append(errs, r.ErrorInfo())
+			continue
+		}
+		values = append(values, r.Value())
+	}
+	if len(errs) > 0 {
+		return Err[[]T](NewMultiError(errs...))
+	}
+	return Ok(values)
+}
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package error_test
+
+import (
+	"errors"
+	"testing"
+
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+)
+
+// TestDomainErrorMultiError tests aggregation of several errors.
+func TestDomainErrorMultiError(t *testing.T) {
+	tf := test.New("Domain.Error.MultiError")
+
+	validation := domerr.NewValidationError("Person name cannot be empty")
+	notFound := domerr.NewNotFoundError("no person named Bob")
+	infra := domerr.NewInfrastructureError("write failed: broken pipe")
+
+	// ========================================================================
+	// Test: Severity ordering
+	// ========================================================================
+
+	tf.RunTest("Severity - Infrastructure > NotFound",
+		domerr.InfrastructureError.Severity() > domerr.NotFoundError.Severity())
+	tf.RunTest("Severity - NotFound > Validation",
+		domerr.NotFoundError.Severity() > domerr.ValidationError.Severity())
+	tf.RunTest("Severity - Validation > unknown kind",
+		domerr.ValidationError.Severity() > domerr.ErrorKind(99).Severity())
+
+	// ========================================================================
+	// Test: Overall Kind is the most severe
+	// ========================================================================
+
+	cases := []struct {
+		name string
+		errs []domerr.ErrorType
+		want domerr.ErrorKind
+	}{
+		{"Validation only", []domerr.ErrorType{validation, validation}, domerr.ValidationError},
+		{"Validation + NotFound", []domerr.ErrorType{validation, notFound}, domerr.NotFoundError},
+		{"Infrastructure first", []domerr.ErrorType{infra, validation, notFound}, domerr.InfrastructureError},
+		{"Infrastructure last", []domerr.ErrorType{validation, notFound, infra}, domerr.InfrastructureError},
+	}
+	for _, tc := range cases {
+		tf.AssertEqual(tc.name+" - Kind", domerr.NewMultiError(tc.errs...).Kind, tc.want)
+	}
+
+	// ========================================================================
+	// Test: Rendering as a numbered list
+	// ========================================================================
+
+	mixed := domerr.NewMultiError(validation, notFound, infra)
+	tf.AssertEqual("Mixed - Message", mixed.Message,
+		"3 errors:\n"+
+			"1. ValidationError: Person name cannot be empty\n"+
+			"2. NotFoundError: no person named Bob\n"+
+			"3. InfrastructureError: write failed: broken pipe")
+
+	m := domerr.MultiError{validation, infra}
+	var err error = m
+	tf.AssertEqual("MultiError - implements error", err.Error(),
+		"2 errors:\n1. ValidationError: Person name cannot be empty\n2. InfrastructureError: write failed: broken pipe")
+	var target domerr.MultiError
+	tf.RunTest("MultiError - errors.As recovers it", errors.As(err, &target) && len(target) == 2)
+
+	// ========================================================================
+	// Test: Edge cases
+	// ========================================================================
+
+	tf.AssertEqual("Single error - returned unchanged", domerr.NewMultiError(notFound), notFound)
+	tf.AssertEqual("MultiError of one - singular heading",
+		domerr.MultiError{validation}.Error(), "1 error:\n1. ValidationError: Person name cannot be empty")
+	tf.AssertEqual("Empty - Kind", domerr.MultiError{}.Kind(), domerr.ValidationError)
+	tf.AssertEqual("Empty - Message", domerr.MultiError{}.Error(), "0 errors:")
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
+
+// TestDomainErrorCollectAll tests aggregation of every error via CollectAll.
+func TestDomainErrorCollectAll(t *testing.T) {
+	tf := test.New("Domain.Error.CollectAll")
+	emptyName := domerr.NewValidationError("Person name cannot be empty")
+	tooLong := domerr.NewValidationError("Person name exceeds maximum length of 100 characters")
+
+	// ========================================================================
+	// Test: All Ok - values in order
+	// ========================================================================
+
+	all := domerr.CollectAll([]domerr.Result[string]{domerr.Ok("Alice"), domerr.Ok("Bob")})
+	tf.RunTest("All Ok - IsOk", all.IsOk())
+	tf.AssertEqual("All Ok - values in order", all.Value(), []string{"Alice", "Bob"})
+
+	none := domerr.CollectAll[string](nil)
+	tf.RunTest("No results - Ok", none.IsOk())
+	tf.AssertEqual("No results - empty slice", none.Value(), []string{})
+
+	// ========================================================================
+	// Test: Two errors aggregated, message lists both
+	// ========================================================================
+
+	two := domerr.CollectAll([]domerr.Result[string]{
+		domerr.Err[string](emptyName), domerr.Ok("Bob"), domerr.Err[string](tooLong),
+	})
+	tf.AssertError("Two errors - ValidationError", two, domerr.ValidationError)
+	tf.AssertEqual("Two errors - aggregated", two.ErrorInfo(), domerr.NewMultiError(emptyName, tooLong))
+	tf.AssertEqual("Two errors - message lists both", two.ErrorInfo().Message,
+		"2 errors:\n"+
+			"1. ValidationError: Person name cannot be empty\n"+
+			"2. ValidationError: Person name exceeds maximum length of 100 characters")
+
+	// ========================================================================
+	// Test: Single error unchanged; most severe kind wins
+	// ========================================================================
+
+	one := domerr.CollectAll([]domerr.Result[int]{domerr.Ok(1), domerr.Err[int](emptyName)})
+	tf.AssertEqual("Single error - unchanged", one.ErrorInfo(), emptyName)
+
+	infra := domerr.NewInfrastructureError("write failed: broken pipe")
+	mixed := domerr.CollectAll([]domerr.Result[int]{domerr.Err[int](emptyName), domerr.Err[int](infra)})
+	tf.AssertEqual("Mixed - most severe kind", mixed.ErrorInfo().Kind, domerr.InfrastructureError)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
@@ -24,6 +24,11 @@
 // Package error provides domain error types and Result monad for error handling.
 package error
 
+import (
+	"errors"
+	"fmt"
+)
+
 // Result represents either a successful value of type T or an error.
 // This is the core functional error handling type.
 //
@@ -71,6 +76,25 @@ func Err[T any](err ErrorType) Result[T] {
 	}
 }
 
+// Try runs f and captures its outcome as a Result.
+// A panic inside f is recovered and converted to an InfrastructureError
+// whose message is "panic: <recovered value>", so it never escapes.
+//
+// Intended for wrapping code the domain does not control (e.g., third-party
+// parsing inside a future value object constructor).
+//
+// Example:
+//
+//	result := Try(func() int { return mustParse(s) })
+func Try[T any](f func() T) (result Result[T]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = Err[T](NewInfrastructureError(fmt.Sprintf("panic: %v", r)))
+		}
+	}()
+	return Ok(f())
+}
+
 // ============================================================================
 // Query functions
 // ============================================================================
@@ -85,6 +109,85 @@ func (r Result[T]) IsError() bool {
 	return !r.isOk
 }
 
+// IsOkAnd returns true if the Result is Ok and its value satisfies pred.
+// pred is not called for an Error.
+//
+// Example:
+//
+//	if result.IsOkAnd(func(p Person) bool { return p.GetTitle().IsSome() }) { ... }
+func (r Result[T]) IsOkAnd(pred func(T) bool) bool {
+	return r.isOk && pred(r.value)
+}
+
+// IsErrAnd returns true if the Result is an Error that satisfies pred.
+// pred is not called for an Ok.
+//
+// Example:
+//
+//	if result.IsErrAnd(func(e ErrorType) bool { return e.Kind == ValidationError }) { ... }
+func (r Result[T]) IsErrAnd(pred func(ErrorType) bool) bool {
+	return !r.isOk && pred(r.err)
+}
+
+// ResultState discriminates the two states of a Result, for use in a
+// switch statement instead of paired IsOk/IsError checks.
+type ResultState int
+
+const (
+	// StateOk is the state of a Result holding a value.
+	StateOk ResultState = iota
+
+	// StateErr is the state of a Result holding an ErrorType.
+	StateErr
+)
+
+// String returns "Ok" or "Err".
+func (s ResultState) String() string {
+	if s == StateOk {
+		return "Ok"
+	}
+	return "Err"
+}
+
+// State returns StateOk or StateErr.
+//
+// Example:
+//
+//	switch result.State() {
+//	case StateOk:
+//	    use(result.Value())
+//	case StateErr:
+//	    report(result.ErrorInfo())
+//	}
+//
+// Contract:
+//   - Post: State() == StateOk exactly when IsOk()
+func (r Result[T]) State() ResultState {
+	if r.isOk {
+		return StateOk
+	}
+	return StateErr
+}
+
+// ResultsEqual reports whether a and b are in the same state with equal
+// contents: both Ok with equal values, or both Error with equal Kind and
+// Message. Intended mainly for test assertions.
+//
+// Example:
+//
+//	if !ResultsEqual(got, Ok(42)) { t.Errorf(...) }
+func ResultsEqual[T comparable](a, b Result[T]) bool {
+	if a.isOk != b.isOk {
+		return false
+	}
+	if a.isOk {
+		return a.value == b.value
+	}
+	// Compare fields, not the struct: the debug stack is not part of an
+	// error's identity
+	return a.err.Kind == b.err.Kind && a.err.Message == b.err.Message
+}
+
 // ============================================================================
 // Value extraction (UNSAFE - require precondition checks)
 // ============================================================================
@@ -231,6 +334,26 @@ func (r Result[T]) AndThen(f func(T) Result[T]) Result[T] {
 	return r
 }
 
+// Do runs f only if r is Ok and returns f's Result; an Err short-circuits
+// and f is not called. It is AndThen for steps that ignore the value,
+// intended for sequencing Unit-valued side effects.
+//
+// Go cannot declare a method on Result[Unit] alone, so Do is available on
+// every Result[T]; with a non-Unit T prefer AndThen.
+//
+// Example:
+//
+//	// Write the greeting, then a footer; stop at the first failure
+//	result := writer.Write(ctx, greeting).Do(func() Result[Unit] {
+//	    return writer.Write(ctx, footer)
+//	})
+func (r Result[T]) Do(f func() Result[T]) Result[T] {
+	if r.isOk {
+		return f()
+	}
+	return r
+}
+
 // AndThenTo chains fallible operations that return a different type U.
 //
 // Example:
@@ -243,14 +366,60 @@ func AndThenTo[T any, U any](r Result[T], f func(T) Result[U]) Result[U] {
 	return Err[U](r.err)
 }
 
+// And returns b if a is Ok, otherwise a's error.
+// Both Results are eagerly evaluated; use AndThenTo when b depends on a's value.
+// Useful for requiring an independent precondition before proceeding.
+//
+// Example:
+//
+//	result := And(cmd.Validate(), createPerson(name))
+func And[T any, U any](a Result[T], b Result[U]) Result[U] {
+	if a.isOk {
+		return b
+	}
+	return Err[U](a.err)
+}
+
+// Pair holds two values produced by Zip.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip combines two independent Results: Ok(Pair{a, b}) if both are Ok,
+// otherwise the first error (a's error wins when both fail).
+// Both Results are eagerly evaluated, like And.
+//
+// Example:
+//
+//	both := Zip(CreatePerson(name), CreateLocale(code))
+//	greeting := AndThenTo(both, func(p Pair[Person, Locale]) Result[string] {
+//	    return p.First.GreetingMessageFor(p.Second)
+//	})
+func Zip[A any, B any](a Result[A], b Result[B]) Result[Pair[A, B]] {
+	if !a.isOk {
+		return Err[Pair[A, B]](a.err)
+	}
+	if !b.isOk {
+		return Err[Pair[A, B]](b.err)
+	}
+	return Ok(Pair[A, B]{First: a.value, Second: b.value})
+}
+
 // MapError transforms the error value if Error, propagates Ok if Ok.
-// Use to add context to errors as they propagate up call stack.
+// Use to add context to errors as they propagate up call stack, or to
+// reclassify an error's Kind before it reaches the presentation layer.
 //
 // Example:
 //
 //	result := operation().MapError(func(e ErrorType) ErrorType {
 //	    return ErrorType{Kind: e.Kind, Message: "context: " + e.Message}
 //	})
+//
+//	// Reclassify: treat a lookup failure as invalid user input
+//	result := lookup().MapError(func(e ErrorType) ErrorType {
+//	    return NewValidationError("unknown name: " + e.Message)
+//	})
 func (r Result[T]) MapError(f func(ErrorType) ErrorType) Result[T] {
 	if !r.isOk {
 		return Err[T](f(r.err))
@@ -258,6 +427,41 @@ func (r Result[T]) MapError(f func(ErrorType) ErrorType) Result[T] {
 	return r
 }
 
+// Filter keeps an Ok value only if it satisfies pred; otherwise the Result
+// becomes Err(onFail). An Error Result passes through and pred is not called.
+//
+// Example:
+//
+//	// Reject reserved names after the Person has been created
+//	result := CreatePerson(name).Filter(
+//	    func(p Person) bool { return p.GetName() != "root" },
+//	    NewValidationError("name is reserved"),
+//	)
+func (r Result[T]) Filter(pred func(T) bool, onFail ErrorType) Result[T] {
+	if r.isOk && !pred(r.value) {
+		return Err[T](onFail)
+	}
+	return r
+}
+
+// Ensure turns an Ok into Err(onFail) when cond is false; an Err passes
+// through unchanged. It is Filter for conditions that do not depend on the
+// value, intended for post-write invariants on Unit-valued results.
+//
+// Go cannot declare a method on Result[Unit] alone, so Ensure is available
+// on every Result[T]; with a non-Unit T prefer Filter.
+//
+// Example:
+//
+//	// Treat a zero-byte write as a failure
+//	result := writer.Write(ctx, msg).Ensure(n > 0, NewInfrastructureError("nothing written"))
+func (r Result[T]) Ensure(cond bool, onFail ErrorType) Result[T] {
+	if r.isOk && !cond {
+		return Err[T](onFail)
+	}
+	return r
+}
+
 // ============================================================================
 // Fallback and recovery
 // ============================================================================
@@ -275,6 +479,16 @@ func (r Result[T]) Fallback(alternative Result[T]) Result[T] {
 	return alternative
 }
 
+// Or returns a if it is Ok, otherwise b.
+// Function form of Fallback, reading naturally alongside And.
+//
+// Example:
+//
+//	result := Or(fromCache(), fromDisk())
+func Or[T any](a, b Result[T]) Result[T] {
+	return a.Fallback(b)
+}
+
 // FallbackWith tries Self, if Error then computes alternative lazily via f.
 // Use when alternative is expensive to compute.
 //
@@ -302,11 +516,23 @@ func (r Result[T]) Recover(handle func(ErrorType) T) T {
 }
 
 // RecoverWith turns error into another Result via handle function.
-// Handle might succeed or return different error.
+// Handle might succeed or return different error. Ok passes through
+// untouched (handle is not called).
+//
+// This is the error-to-Ok fallback combinator; use Recover when the
+// fallback cannot fail.
 //
 // Example:
 //
 //	result := original.RecoverWith(func(e ErrorType) Result[T] { return retry() })
+//
+//	// Fall back to a default name only for validation failures
+//	name := parsed.RecoverWith(func(e ErrorType) Result[string] {
+//	    if e.Kind == ValidationError {
+//	        return Ok("stranger")
+//	    }
+//	    return Err[string](e)
+//	})
 func (r Result[T]) RecoverWith(handle func(ErrorType) Result[T]) Result[T] {
 	if r.isOk {
 		return r
@@ -335,3 +561,92 @@ func (r Result[T]) Tap(onOk func(T), onError func(ErrorType)) Result[T] {
 	}
 	return r
 }
+
+// Inspect calls f with the value if Ok, then returns the Result unchanged.
+// Use for observation (logging, metrics) on the success track only.
+//
+// Example:
+//
+//	result := CreatePerson(name).Inspect(func(p Person) { log.Debug("created", p) })
+func (r Result[T]) Inspect(f func(T)) Result[T] {
+	if r.isOk {
+		f(r.value)
+	}
+	return r
+}
+
+// InspectErr calls f with the error if Error, then returns the Result unchanged.
+// Use for observation (logging, metrics) on the error track only.
+//
+// Example:
+//
+//	result := CreatePerson(name).InspectErr(func(e ErrorType) { log.Warn("rejected", e) })
+func (r Result[T]) InspectErr(f func(ErrorType)) Result[T] {
+	if !r.isOk {
+		f(r.err)
+	}
+	return r
+}
+
+// ============================================================================
+// Interop with standard Go errors
+// ============================================================================
+
+// ToStdError converts the Result to a plain Go error for stdlib-style APIs.
+// Returns nil if Ok. If Error, returns the ErrorType as an error so callers
+// can recover the Kind with errors.As.
+//
+// Example:
+//
+//	if err := result.ToStdError(); err != nil {
+//	    var info ErrorType
+//	    if errors.As(err, &info) && info.Kind == ValidationError {
+//	        // Handle validation error
+//	    }
+//	}
+func (r Result[T]) ToStdError() error {
+	if r.isOk {
+		return nil
+	}
+	return r.err
+}
+
+// ToError converts the Result to Go's (T, error) idiom.
+// Returns (value, nil) if Ok; (zero T, ErrorType) if Error.
+//
+// Example:
+//
+//	person, err := CreatePerson("Alice").ToError()
+//	if err != nil {
+//	    return err
+//	}
+func (r Result[T]) ToError() (T, error) {
+	if r.isOk {
+		return r.value, nil
+	}
+	var zero T
+	return zero, r.err
+}
+
+// FromError converts Go's (T, error) idiom into a Result.
+//
+// Kind detection:
+//   - err == nil: Ok(value)
+//   - err is (or wraps) an ErrorType: Err with that ErrorType unchanged,
+//     so ToError followed by FromError preserves the Kind
+//   - any other error: Err(InfrastructureError) carrying err.Error(),
+//     since foreign errors originate outside the domain rules
+//
+// Example:
+//
+//	n := FromError(strconv.Atoi(s))
+func FromError[T any](value T, err error) Result[T] {
+	if err == nil {
+		return Ok(value)
+	}
+	var info ErrorType
+	if errors.As(err, &info) {
+		return Err[T](info)
+	}
+	return Err[T](NewInfrastructureError(err.Error()))
+}
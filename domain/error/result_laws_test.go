@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package error_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+)
+
+// lawCases is the number of generated Results each law is checked against.
+const lawCases = 500
+
+// genResults returns a deterministic mix of Ok and Err Results (roughly one
+// in four is an error), so failures are reproducible.
+func genResults(n int) []domerr.Result[int] {
+	rng := rand.New(rand.NewSource(42))
+	results := make([]domerr.Result[int], n)
+	for i := range results {
+		x := rng.Intn(2001) - 1000
+		switch rng.Intn(4) {
+		case 0:
+			if rng.Intn(2) == 0 {
+				results[i] = domerr.Err[int](domerr.NewValidationError(fmt.Sprintf("bad %d", x)))
+			} else {
+				results[i] = domerr.Err[int](domerr.NewInfrastructureError(fmt.Sprintf("io %d", x)))
+			}
+		default:
+			results[i] = domerr.Ok(x)
+		}
+	}
+	return results
+}
+
+// Pure functions used as f and g in the laws. The Result-returning ones
+// fail on some inputs so both tracks are exercised.
+var (
+	double = func(x int) int { return x * 2 }
+	inc    = func(x int) int { return x + 1 }
+
+	halveEven = func(x int) domerr.Result[int] {
+		if x%2 != 0 {
+			return domerr.Err[int](domerr.NewValidationError(fmt.Sprintf("%d is odd", x)))
+		}
+		return domerr.Ok(x / 2)
+	}
+	nonNegative = func(x int) domerr.Result[int] {
+		if x < 0 {
+			return domerr.Err[int](domerr.NewValidationError(fmt.Sprintf("%d is negative", x)))
+		}
+		return domerr.Ok(x)
+	}
+)
+
+// checkLaw asserts law holds for every generated Result, reporting the
+// first counterexample.
+func checkLaw(t *testing.T, tf *test.Framework, name string, law func(r domerr.Result[int]) bool) {
+	t.Helper()
+	for _, r := range genResults(lawCases) {
+		if !law(r) {
+			t.Errorf("%s: counterexample %+v", name, r)
+			tf.RunTest(name, false)
+			return
+		}
+	}
+	tf.RunTest(fmt.Sprintf("%s (%d cases)", name, lawCases), true)
+}
+
+// TestDomainErrorResultLaws checks that the Result combinators obey the
+// functor and monad laws. Map/MapTo is fmap, Ok is unit (return), and
+// AndThen/AndThenTo is bind (FlatMap).
+func TestDomainErrorResultLaws(t *testing.T) {
+	tf := test.New("Domain.Error.Result.Laws")
+
+	// ========================================================================
+	// Functor laws
+	// ========================================================================
+
+	// Identity: mapping the identity function changes nothing.
+	//   r.Map(id) == r
+	checkLaw(t, tf, "Functor identity", func(r domerr.Result[int]) bool {
+		return domerr.ResultsEqual(r.Map(func(x int) int { return x }), r)
+	})
+
+	// Composition: mapping f then g equals mapping g∘f once.
+	//   r.Map(f).Map(g) == r.Map(g ∘ f)
+	checkLaw(t, tf, "Functor composition", func(r domerr.Result[int]) bool {
+		return domerr.ResultsEqual(r.Map(double).Map(inc), r.Map(func(x int) int { return inc(double(x)) }))
+	})
+
+	// MapTo agrees with Map when the types coincide.
+	//   MapTo(r, f) == r.Map(f)
+	checkLaw(t, tf, "MapTo agrees with Map", func(r domerr.Result[int]) bool {
+		return domerr.ResultsEqual(domerr.MapTo(r, double), r.Map(double))
+	})
+
+	// ========================================================================
+	// Monad laws
+	// ========================================================================
+
+	// Left identity: wrapping a value and binding f is just f.
+	//   AndThenTo(Ok(x), f) == f(x)
+	checkLaw(t, tf, "Left identity", func(r domerr.Result[int]) bool {
+		x := r.UnwrapOr(7)
+		return domerr.ResultsEqual(domerr.AndThenTo(domerr.Ok(x), halveEven), halveEven(x)) &&
+			domerr.ResultsEqual(domerr.Ok(x).AndThen(nonNegative), nonNegative(x))
+	})
+
+	// Right identity: binding Ok changes nothing.
+	//   r.AndThen(Ok) == r
+	checkLaw(t, tf, "Right identity", func(r domerr.Result[int]) bool {
+		return domerr.ResultsEqual(r.AndThen(domerr.Ok[int]), r) &&
+			domerr.ResultsEqual(domerr.AndThenTo(r, domerr.Ok[int]), r)
+	})
+
+	// Associativity: nesting of binds does not matter.
+	//   r.AndThen(f).AndThen(g) == r.AndThen(x => f(x).AndThen(g))
+	checkLaw(t, tf, "Associativity", func(r domerr.Result[int]) bool {
+		left := r.AndThen(halveEven).AndThen(nonNegative)
+		right := r.AndThen(func(x int) domerr.Result[int] { return halveEven(x).AndThen(nonNegative) })
+		return domerr.ResultsEqual(left, right)
+	})
+
+	// Map is bind composed with unit.
+	//   r.Map(f) == r.AndThen(x => Ok(f(x)))
+	checkLaw(t, tf, "Map via AndThen", func(r domerr.Result[int]) bool {
+		return domerr.ResultsEqual(r.Map(double), r.AndThen(func(x int) domerr.Result[int] { return domerr.Ok(double(x)) }))
+	})
+
+	// ========================================================================
+	// Error-track laws
+	// ========================================================================
+
+	// Recovery is the mirror image of bind: it leaves Ok untouched and
+	// applies the handler to Err.
+	//   Ok(x).RecoverWith(h) == Ok(x);  Err(e).RecoverWith(h) == h(e)
+	handler := func(e domerr.ErrorType) domerr.Result[int] { return domerr.Ok(len(e.Message)) }
+	checkLaw(t, tf, "RecoverWith", func(r domerr.Result[int]) bool {
+		if r.IsOk() {
+			return domerr.ResultsEqual(r.RecoverWith(handler), r)
+		}
+		return domerr.ResultsEqual(r.RecoverWith(handler), handler(r.ErrorInfo()))
+	})
+
+	// Recover always yields a value, consistent with RecoverWith.
+	//   Ok(r.Recover(h)) == r.RecoverWith(e => Ok(h(e)))
+	checkLaw(t, tf, "Recover agrees with RecoverWith", func(r domerr.Result[int]) bool {
+		h := func(e domerr.ErrorType) int { return -len(e.Message) }
+		return domerr.ResultsEqual(domerr.Ok(r.Recover(h)),
+			r.RecoverWith(func(e domerr.ErrorType) domerr.Result[int] { return domerr.Ok(h(e)) }))
+	})
+
+	// Attaching a debug stack does not change equality.
+	//   r.MapError(e => e.WithStack(s)) == r
+	checkLaw(t, tf, "WithStack preserves equality", func(r domerr.Result[int]) bool {
+		return domerr.ResultsEqual(r.MapError(func(e domerr.ErrorType) domerr.ErrorType {
+			return e.WithStack([]byte("goroutine 1 [running]:"))
+		}), r)
+	})
+
+	// MapError identity.
+	//   r.MapError(id) == r
+	checkLaw(t, tf, "MapError identity", func(r domerr.Result[int]) bool {
+		return domerr.ResultsEqual(r.MapError(func(e domerr.ErrorType) domerr.ErrorType { return e }), r)
+	})
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
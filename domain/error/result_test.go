@@ -4,6 +4,8 @@
 package error_test
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 
 	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
@@ -144,3 +146,573 @@ func TestDomainErrorResult(t *testing.T) {
 	// Print summary and fail test if any failed
 	tf.Summary(t)
 }
+
+// TestDomainErrorResultState tests the switch-friendly State discriminant.
+func TestDomainErrorResultState(t *testing.T) {
+	tf := test.New("Domain.Error.Result.State")
+
+	ok := domerr.Ok(42)
+	failed := domerr.Err[int](domerr.NewValidationError("bad input"))
+
+	// ========================================================================
+	// Test: State matches IsOk/IsError
+	// ========================================================================
+
+	tf.AssertEqual("Ok - StateOk", ok.State(), domerr.StateOk)
+	tf.AssertEqual("Err - StateErr", failed.State(), domerr.StateErr)
+	tf.RunTest("Ok - consistent with IsOk", (ok.State() == domerr.StateOk) == ok.IsOk())
+	tf.RunTest("Err - consistent with IsError", (failed.State() == domerr.StateErr) == failed.IsError())
+
+	// ========================================================================
+	// Test: Usable as a switch discriminant
+	// ========================================================================
+
+	describe := func(r domerr.Result[int]) string {
+		switch r.State() {
+		case domerr.StateOk:
+			return "value"
+		case domerr.StateErr:
+			return r.ErrorInfo().Message
+		}
+		return "unreachable"
+	}
+	tf.AssertEqual("Switch - Ok branch", describe(ok), "value")
+	tf.AssertEqual("Switch - Err branch", describe(failed), "bad input")
+
+	// ========================================================================
+	// Test: String
+	// ========================================================================
+
+	tf.AssertEqual("String - Ok", domerr.StateOk.String(), "Ok")
+	tf.AssertEqual("String - Err", domerr.StateErr.String(), "Err")
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
+
+// TestDomainErrorResultIsOkAndIsErrAnd tests the predicate queries.
+func TestDomainErrorResultIsOkAndIsErrAnd(t *testing.T) {
+	tf := test.New("Domain.Error.Result.IsOkAnd")
+
+	ok := domerr.Ok(42)
+	failed := domerr.Err[int](domerr.NewValidationError("bad input"))
+	calls := 0
+	positive := func(v int) bool { calls++; return v > 0 }
+	negative := func(v int) bool { calls++; return v < 0 }
+	isValidation := func(e domerr.ErrorType) bool { calls++; return e.Kind == domerr.ValidationError }
+	isInfra := func(e domerr.ErrorType) bool { calls++; return e.Kind == domerr.InfrastructureError }
+
+	// ========================================================================
+	// Test: IsOkAnd
+	// ========================================================================
+
+	tf.RunTest("IsOkAnd - Ok, predicate passes", ok.IsOkAnd(positive))
+	tf.RunTest("IsOkAnd - Ok, predicate fails", !ok.IsOkAnd(negative))
+	calls = 0
+	tf.RunTest("IsOkAnd - Error is false", !failed.IsOkAnd(positive))
+	tf.AssertEqual("IsOkAnd - Error skips predicate", calls, 0)
+
+	// ========================================================================
+	// Test: IsErrAnd
+	// ========================================================================
+
+	tf.RunTest("IsErrAnd - Error, predicate passes", failed.IsErrAnd(isValidation))
+	tf.RunTest("IsErrAnd - Error, predicate fails", !failed.IsErrAnd(isInfra))
+	calls = 0
+	tf.RunTest("IsErrAnd - Ok is false", !ok.IsErrAnd(isValidation))
+	tf.AssertEqual("IsErrAnd - Ok skips predicate", calls, 0)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
+
+// TestDomainErrorResultToStdError tests conversion of Result[T] to a plain Go error.
+func TestDomainErrorResultToStdError(t *testing.T) {
+	tf := test.New("Domain.Error.Result.ToStdError")
+
+	// ========================================================================
+	// Test: Ok converts to nil error
+	// ========================================================================
+
+	okResult := domerr.Ok(42)
+	tf.RunTest("ToStdError with Ok - returns nil", okResult.ToStdError() == nil)
+
+	// ========================================================================
+	// Test: Error converts to non-nil error with message
+	// ========================================================================
+
+	errResult := domerr.Err[int](domerr.NewValidationError("name is invalid"))
+	err := errResult.ToStdError()
+	tf.RunTest("ToStdError with Error - returns non-nil", err != nil)
+	if err != nil {
+		tf.RunTest("ToStdError with Error - message matches ErrorType.Error()",
+			err.Error() == "ValidationError: name is invalid")
+	}
+
+	// ========================================================================
+	// Test: errors.As extracts the ErrorType and its Kind
+	// ========================================================================
+
+	infraErr := domerr.Err[string](domerr.NewInfrastructureError("disk full")).ToStdError()
+	wrapped := fmt.Errorf("saving greeting: %w", infraErr)
+	var info domerr.ErrorType
+	tf.RunTest("ToStdError - errors.As finds ErrorType through wrapping",
+		errors.As(wrapped, &info))
+	tf.RunTest("ToStdError - extracted Kind is InfrastructureError",
+		info.Kind == domerr.InfrastructureError)
+	tf.RunTest("ToStdError - extracted Message is preserved",
+		info.Message == "disk full")
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
+
+// TestDomainErrorResultFromError tests the (T, error) bridge in both directions.
+func TestDomainErrorResultFromError(t *testing.T) {
+	tf := test.New("Domain.Error.Result.FromError")
+
+	// ========================================================================
+	// Test: nil error converts to Ok
+	// ========================================================================
+
+	okResult := domerr.FromError(42, nil)
+	tf.RunTest("FromError nil - IsOk", okResult.IsOk())
+	tf.AssertEqual("FromError nil - value preserved", okResult.UnwrapOr(0), 42)
+
+	value, err := domerr.Ok("Alice").ToError()
+	tf.AssertEqual("ToError Ok - value", value, "Alice")
+	tf.RunTest("ToError Ok - nil error", err == nil)
+
+	// ========================================================================
+	// Test: Foreign error converts to InfrastructureError
+	// ========================================================================
+
+	foreign := domerr.FromError(0, errors.New("connection reset"))
+	tf.AssertError("FromError foreign - InfrastructureError", foreign, domerr.InfrastructureError)
+	if foreign.IsError() {
+		tf.AssertEqual("FromError foreign - message preserved",
+			foreign.ErrorInfo().Message, "connection reset")
+	}
+
+	value, err = domerr.Err[string](domerr.NewValidationError("bad")).ToError()
+	tf.AssertEqual("ToError Error - zero value", value, "")
+	tf.RunTest("ToError Error - non-nil error", err != nil)
+
+	// ========================================================================
+	// Test: Round trip preserves Kind and Message
+	// ========================================================================
+
+	for _, original := range []domerr.ErrorType{
+		domerr.NewValidationError("name is invalid"),
+		domerr.NewInfrastructureError("disk full"),
+	} {
+		_, err := domerr.Err[int](original).ToError()
+		back := domerr.FromError(0, err)
+		tf.AssertEqual("Round trip "+original.Kind.String()+" - ErrorType preserved",
+			back.ErrorInfo(), original)
+
+		wrapped := domerr.FromError(0, fmt.Errorf("context: %w", err))
+		tf.AssertEqual("Round trip wrapped "+original.Kind.String()+" - Kind preserved",
+			wrapped.ErrorInfo().Kind, original.Kind)
+	}
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
+
+// TestDomainErrorResultTry tests panic recovery via Try.
+func TestDomainErrorResultTry(t *testing.T) {
+	tf := test.New("Domain.Error.Result.Try")
+
+	// ========================================================================
+	// Test: Normal return becomes Ok
+	// ========================================================================
+
+	okResult := domerr.Try(func() int { return 42 })
+	tf.RunTest("Try normal - IsOk", okResult.IsOk())
+	tf.AssertEqual("Try normal - value", okResult.UnwrapOr(0), 42)
+
+	// ========================================================================
+	// Test: Panic becomes InfrastructureError
+	// ========================================================================
+
+	panicked := domerr.Try(func() string { panic("parser exploded") })
+	tf.AssertError("Try panic - InfrastructureError", panicked, domerr.InfrastructureError)
+	if panicked.IsError() {
+		tf.AssertEqual("Try panic - message", panicked.ErrorInfo().Message, "panic: parser exploded")
+	}
+
+	runtimeErr := domerr.Try(func() int {
+		var values []int
+		return values[3]
+	})
+	tf.AssertError("Try runtime panic - InfrastructureError", runtimeErr, domerr.InfrastructureError)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
+
+// TestDomainErrorResultAndOr tests the And/Or combinators.
+func TestDomainErrorResultAndOr(t *testing.T) {
+	tf := test.New("Domain.Error.Result.AndOr")
+
+	okA := domerr.Ok(1)
+	errA := domerr.Err[int](domerr.NewValidationError("a failed"))
+	okB := domerr.Ok("b")
+	errB := domerr.Err[string](domerr.NewInfrastructureError("b failed"))
+
+	// ========================================================================
+	// Test: And - all four combinations
+	// ========================================================================
+
+	tf.AssertEqual("And ok/ok - returns b", domerr.And(okA, okB), okB)
+	tf.AssertEqual("And ok/err - returns b's error", domerr.And(okA, errB), errB)
+	tf.AssertEqual("And err/ok - returns a's error",
+		domerr.And(errA, okB), domerr.Err[string](domerr.NewValidationError("a failed")))
+	tf.AssertEqual("And err/err - a's error wins",
+		domerr.And(errA, errB), domerr.Err[string](domerr.NewValidationError("a failed")))
+
+	// ========================================================================
+	// Test: Or - both branches
+	// ========================================================================
+
+	other := domerr.Ok(2)
+	tf.AssertEqual("Or ok - returns a", domerr.Or(okA, other), okA)
+	tf.AssertEqual("Or err - returns b", domerr.Or(errA, other), other)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
+
+// TestDomainErrorResultZip tests combining two independent Results.
+func TestDomainErrorResultZip(t *testing.T) {
+	tf := test.New("Domain.Error.Result.Zip")
+
+	okA := domerr.Ok(1)
+	errA := domerr.Err[int](domerr.NewValidationError("a failed"))
+	okB := domerr.Ok("b")
+	errB := domerr.Err[string](domerr.NewInfrastructureError("b failed"))
+
+	// ========================================================================
+	// Test: All four combinations
+	// ========================================================================
+
+	tf.AssertEqual("Zip ok/ok - both values",
+		domerr.Zip(okA, okB), domerr.Ok(domerr.Pair[int, string]{First: 1, Second: "b"}))
+	tf.AssertEqual("Zip err/ok - first error",
+		domerr.Zip(errA, okB), domerr.Err[domerr.Pair[int, string]](domerr.NewValidationError("a failed")))
+	tf.AssertEqual("Zip ok/err - second error",
+		domerr.Zip(okA, errB), domerr.Err[domerr.Pair[int, string]](domerr.NewInfrastructureError("b failed")))
+	tf.AssertEqual("Zip err/err - a's error wins",
+		domerr.Zip(errA, errB), domerr.Err[domerr.Pair[int, string]](domerr.NewValidationError("a failed")))
+
+	// ========================================================================
+	// Test: Composes with AndThenTo
+	// ========================================================================
+
+	sum := domerr.AndThenTo(domerr.Zip(okA, domerr.Ok(41)), func(p domerr.Pair[int, int]) domerr.Result[int] {
+		return domerr.Ok(p.First + p.Second)
+	})
+	tf.AssertEqual("Zip then AndThenTo - combined", sum, domerr.Ok(42))
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
+
+// TestDomainErrorResultsEqual tests structural Result comparison.
+func TestDomainErrorResultsEqual(t *testing.T) {
+	tf := test.New("Domain.Error.ResultsEqual")
+
+	// ========================================================================
+	// Test: Ok results
+	// ========================================================================
+
+	tf.RunTest("Ok equal values - true", domerr.ResultsEqual(domerr.Ok(1), domerr.Ok(1)))
+	tf.RunTest("Ok different values - false", !domerr.ResultsEqual(domerr.Ok(1), domerr.Ok(2)))
+
+	// ========================================================================
+	// Test: Error results
+	// ========================================================================
+
+	validation := domerr.Err[int](domerr.NewValidationError("bad"))
+	tf.RunTest("Error equal Kind and Message - true",
+		domerr.ResultsEqual(validation, domerr.Err[int](domerr.NewValidationError("bad"))))
+	tf.RunTest("Error different Kind - false",
+		!domerr.ResultsEqual(validation, domerr.Err[int](domerr.NewInfrastructureError("bad"))))
+	tf.RunTest("Error different Message - false",
+		!domerr.ResultsEqual(validation, domerr.Err[int](domerr.NewValidationError("worse"))))
+
+	// ========================================================================
+	// Test: Mismatched discriminant
+	// ========================================================================
+
+	tf.RunTest("Ok vs Error - false", !domerr.ResultsEqual(domerr.Ok(0), validation))
+	tf.RunTest("Error vs Ok - false", !domerr.ResultsEqual(validation, domerr.Ok(0)))
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
+
+// TestDomainErrorResultFilter tests predicate-driven validation via Filter.
+func TestDomainErrorResultFilter(t *testing.T) {
+	tf := test.New("Domain.Error.Result.Filter")
+	isEven := func(n int) bool { return n%2 == 0 }
+	notEven := domerr.NewValidationError("must be even")
+
+	// ========================================================================
+	// Test: Predicate passes - unchanged
+	// ========================================================================
+
+	tf.AssertEqual("Pass - Ok unchanged", domerr.Ok(4).Filter(isEven, notEven), domerr.Ok(4))
+
+	// ========================================================================
+	// Test: Predicate fails - becomes the provided error
+	// ========================================================================
+
+	failed := domerr.Ok(3).Filter(isEven, notEven)
+	tf.AssertEqual("Fail - provided error", failed, domerr.Err[int](notEven))
+
+	// ========================================================================
+	// Test: Error passes through without evaluating the predicate
+	// ========================================================================
+
+	called := false
+	original := domerr.Err[int](domerr.NewInfrastructureError("disk full"))
+	passed := original.Filter(func(int) bool { called = true; return false }, notEven)
+	tf.AssertEqual("Error - original error kept", passed, original)
+	tf.RunTest("Error - predicate not evaluated", !called)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
+
+// TestDomainErrorResultEnsure tests invariant checks on Unit-valued results.
+func TestDomainErrorResultEnsure(t *testing.T) {
+	tf := test.New("Domain.Error.Result.Ensure")
+	// struct{} stands in for application/model.Unit, which domain cannot import
+	unit := domerr.Ok(struct{}{})
+	nothingWritten := domerr.NewInfrastructureError("nothing written")
+
+	// ========================================================================
+	// Test: Condition holds - unchanged
+	// ========================================================================
+
+	tf.AssertEqual("True - Ok unchanged", unit.Ensure(true, nothingWritten), unit)
+
+	// ========================================================================
+	// Test: Condition fails - becomes the provided error
+	// ========================================================================
+
+	tf.AssertEqual("False - provided error",
+		unit.Ensure(false, nothingWritten), domerr.Err[struct{}](nothingWritten))
+
+	// ========================================================================
+	// Test: Error passes through regardless of the condition
+	// ========================================================================
+
+	original := domerr.Err[struct{}](domerr.NewInfrastructureError("disk full"))
+	tf.AssertEqual("Error, false - original error kept", original.Ensure(false, nothingWritten), original)
+	tf.AssertEqual("Error, true - original error kept", original.Ensure(true, nothingWritten), original)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
+
+// TestDomainErrorResultDo tests sequencing of value-independent steps via Do.
+func TestDomainErrorResultDo(t *testing.T) {
+	tf := test.New("Domain.Error.Result.Do")
+	// struct{} stands in for application/model.Unit, which domain cannot import
+	unit := domerr.Ok(struct{}{})
+	footerFailed := domerr.Err[struct{}](domerr.NewInfrastructureError("footer failed"))
+
+	var steps []string
+	step := func(name string, r domerr.Result[struct{}]) func() domerr.Result[struct{}] {
+		return func() domerr.Result[struct{}] {
+			steps = append(steps, name)
+			return r
+		}
+	}
+
+	// ========================================================================
+	// Test: Ok then Ok - both steps run in order
+	// ========================================================================
+
+	result := step("greeting", unit)().Do(step("footer", unit))
+	tf.AssertEqual("Ok then Ok - Ok", result, unit)
+	tf.AssertEqual("Ok then Ok - steps in order", steps, []string{"greeting", "footer"})
+
+	// ========================================================================
+	// Test: Ok then Error - f's error is returned
+	// ========================================================================
+
+	steps = nil
+	result = unit.Do(step("footer", footerFailed))
+	tf.AssertEqual("Ok then Error - f's error", result, footerFailed)
+	tf.AssertEqual("Ok then Error - f called", steps, []string{"footer"})
+
+	// ========================================================================
+	// Test: Error short-circuits - f not called
+	// ========================================================================
+
+	steps = nil
+	original := domerr.Err[struct{}](domerr.NewInfrastructureError("greeting failed"))
+	result = original.Do(step("footer", unit)).Do(step("signature", unit))
+	tf.AssertEqual("Error - original error kept", result, original)
+	tf.AssertEqual("Error - f not called", len(steps), 0)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
+
+// TestDomainErrorResultMapError tests error-branch transformation via MapError.
+func TestDomainErrorResultMapError(t *testing.T) {
+	tf := test.New("Domain.Error.Result.MapError")
+
+	called := false
+	addContext := func(e domerr.ErrorType) domerr.ErrorType {
+		called = true
+		return domerr.ErrorType{Kind: e.Kind, Message: "writing greeting: " + e.Message}
+	}
+
+	// ========================================================================
+	// Test: Ok passes through untouched (f not called)
+	// ========================================================================
+
+	r1 := domerr.Ok(7).MapError(addContext)
+	tf.RunTest("MapError with Ok - remains Ok", r1.IsOk())
+	tf.RunTest("MapError with Ok - value unchanged", r1.UnwrapOr(0) == 7)
+	tf.RunTest("MapError with Ok - mapping function not called", !called)
+
+	// ========================================================================
+	// Test: Message context is added, Kind preserved
+	// ========================================================================
+
+	r2 := domerr.Err[int](domerr.NewInfrastructureError("disk full")).MapError(addContext)
+	tf.RunTest("MapError add context - remains Error", r2.IsError())
+	if r2.IsError() {
+		info := r2.ErrorInfo()
+		tf.RunTest("MapError add context - Kind preserved",
+			info.Kind == domerr.InfrastructureError)
+		tf.RunTest("MapError add context - Message reflects mapping",
+			info.Message == "writing greeting: disk full")
+	}
+
+	// ========================================================================
+	// Test: Kind is reclassified
+	// ========================================================================
+
+	r3 := domerr.Err[string](domerr.NewInfrastructureError("no such user")).
+		MapError(func(e domerr.ErrorType) domerr.ErrorType {
+			return domerr.NewValidationError("unknown name: " + e.Message)
+		})
+	if r3.IsError() {
+		info := r3.ErrorInfo()
+		tf.RunTest("MapError reclassify - Kind becomes ValidationError",
+			info.Kind == domerr.ValidationError)
+		tf.RunTest("MapError reclassify - Message reflects mapping",
+			info.Message == "unknown name: no such user")
+	} else {
+		tf.RunTest("MapError reclassify - Result should be Error", false)
+	}
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
+
+// TestDomainErrorResultInspect tests the Inspect/InspectErr observation hooks.
+func TestDomainErrorResultInspect(t *testing.T) {
+	tf := test.New("Domain.Error.Result.Inspect")
+
+	// ========================================================================
+	// Test: Ok branch fires Inspect only
+	// ========================================================================
+
+	okSeen, errSeen := 0, 0
+	ok := domerr.Ok("Alice")
+	out1 := ok.
+		Inspect(func(v string) { okSeen++ }).
+		InspectErr(func(e domerr.ErrorType) { errSeen++ })
+	tf.RunTest("Inspect with Ok - Inspect callback fired once", okSeen == 1)
+	tf.RunTest("Inspect with Ok - InspectErr callback not fired", errSeen == 0)
+	tf.RunTest("Inspect with Ok - Result returned unchanged", out1 == ok)
+
+	var seenValue string
+	ok.Inspect(func(v string) { seenValue = v })
+	tf.RunTest("Inspect with Ok - callback receives value", seenValue == "Alice")
+
+	// ========================================================================
+	// Test: Error branch fires InspectErr only
+	// ========================================================================
+
+	okSeen, errSeen = 0, 0
+	bad := domerr.Err[string](domerr.NewValidationError("Person name cannot be empty"))
+	var seenErr domerr.ErrorType
+	out2 := bad.
+		Inspect(func(v string) { okSeen++ }).
+		InspectErr(func(e domerr.ErrorType) { errSeen++; seenErr = e })
+	tf.RunTest("InspectErr with Error - InspectErr callback fired once", errSeen == 1)
+	tf.RunTest("InspectErr with Error - Inspect callback not fired", okSeen == 0)
+	tf.RunTest("InspectErr with Error - callback receives error",
+		seenErr.Kind == domerr.ValidationError && seenErr.Message == "Person name cannot be empty")
+	tf.RunTest("InspectErr with Error - Result returned unchanged", out2 == bad)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
+
+// TestDomainErrorResultRecover tests the Recover/RecoverWith fallbacks.
+func TestDomainErrorResultRecover(t *testing.T) {
+	tf := test.New("Domain.Error.Result.Recover")
+	bad := domerr.Err[string](domerr.NewValidationError("Person name cannot be empty"))
+
+	// ========================================================================
+	// Test: Ok passes through untouched (handle not called)
+	// ========================================================================
+
+	called := false
+	ok := domerr.Ok("Alice")
+	out := ok.RecoverWith(func(e domerr.ErrorType) domerr.Result[string] {
+		called = true
+		return domerr.Ok("stranger")
+	})
+	tf.RunTest("RecoverWith with Ok - Result returned unchanged", out == ok)
+	tf.RunTest("RecoverWith with Ok - handle not called", !called)
+
+	// ========================================================================
+	// Test: Error recovered to Ok
+	// ========================================================================
+
+	var seen domerr.ErrorType
+	recovered := bad.RecoverWith(func(e domerr.ErrorType) domerr.Result[string] {
+		seen = e
+		return domerr.Ok("stranger")
+	})
+	tf.RunTest("RecoverWith with Error - recovered to Ok", recovered.IsOk())
+	tf.AssertEqual("RecoverWith with Error - fallback value", recovered.UnwrapOr(""), "stranger")
+	tf.RunTest("RecoverWith with Error - handle receives error",
+		seen.Kind == domerr.ValidationError && seen.Message == "Person name cannot be empty")
+
+	// ========================================================================
+	// Test: Error recovered to another error
+	// ========================================================================
+
+	rethrown := bad.RecoverWith(func(e domerr.ErrorType) domerr.Result[string] {
+		return domerr.Err[string](domerr.NewInfrastructureError("fallback unavailable"))
+	})
+	tf.AssertError("RecoverWith with Error - new error kind", rethrown, domerr.InfrastructureError)
+	if rethrown.IsError() {
+		tf.AssertEqual("RecoverWith with Error - new error message",
+			rethrown.ErrorInfo().Message, "fallback unavailable")
+	}
+
+	// ========================================================================
+	// Test: Recover always yields a value
+	// ========================================================================
+
+	fallback := func(domerr.ErrorType) string { return "stranger" }
+	tf.AssertEqual("Recover with Ok - value", ok.Recover(fallback), "Alice")
+	tf.AssertEqual("Recover with Error - fallback value", bad.Recover(fallback), "stranger")
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: event
+// Description: Domain events raised by successful business operations
+
+// Package event provides domain events - immutable records of something
+// that happened in the domain, for embedders to react to (analytics,
+// auditing) without changing the operation that raised them.
+//
+// Architecture Notes:
+//   - Part of the DOMAIN layer (innermost, pure business logic)
+//   - Events are plain values; the domain never dispatches them itself
+//   - The application layer raises events and delivers them through an
+//     output port (outbound.EventSinkFunc)
+//   - Pure domain logic - ZERO external module dependencies
+//
+// Usage:
+//
+//	import "github.com/abitofhelp/hybrid_app_go/domain/event"
+//
+//	e := event.NewPersonGreeted(person, clock.Now())
+//	// e.Name == "Alice"
+package event
+
+import (
+	"time"
+
+	"github.com/abitofhelp/hybrid_app_go/domain/valueobject"
+)
+
+// PersonGreeted records that a person was successfully greeted.
+//
+// Contract:
+//   - Name is the validated Person's name (never empty)
+//   - At is when the greeting was delivered, as read from the caller's clock
+type PersonGreeted struct {
+	Name string
+	At   time.Time
+}
+
+// NewPersonGreeted creates the event for person, greeted at the given time.
+//
+// Taking a Person (not a raw string) means the event can only be raised
+// for a name that passed domain validation.
+func NewPersonGreeted(person valueobject.Person, at time.Time) PersonGreeted {
+	return PersonGreeted{Name: person.GetName(), At: at}
+}
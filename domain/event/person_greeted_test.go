@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package event_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_app_go/domain/event"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/domain/valueobject"
+)
+
+// TestDomainEventPersonGreeted tests PersonGreeted construction.
+func TestDomainEventPersonGreeted(t *testing.T) {
+	tf := test.New("Domain.Event.PersonGreeted")
+
+	at := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	person := valueobject.CreatePerson("Alice").Value()
+	e := event.NewPersonGreeted(person, at)
+
+	tf.AssertEqual("Name - from Person", e.Name, "Alice")
+	tf.RunTest("At - as given", e.At.Equal(at))
+
+	titled := valueobject.NewPersonBuilder().WithName("Curie").WithTitle("Dr.").Build().Value()
+	tf.AssertEqual("Name - title not included", event.NewPersonGreeted(titled, at).Name, "Curie")
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: service
+// Description: Greeting domain service (pluggable greeting strategy)
+
+// Package service provides domain services - stateless domain operations
+// that don't naturally belong to a single value object.
+//
+// Architecture Notes:
+//   - Part of the DOMAIN layer (innermost, pure business logic)
+//   - Services operate on value objects; they never perform I/O
+//   - Pure domain logic - ZERO external module dependencies
+//
+// Usage:
+//
+//	import "github.com/abitofhelp/hybrid_app_go/domain/service"
+//
+//	var greeter service.GreetingService = service.DefaultGreetingService{}
+//	greeting := greeter.Greet(person) // "Hello, Alice!"
+package service
+
+import (
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/valueobject"
+)
+
+// GreetingService turns a validated Person into a greeting.
+//
+// Implementations are greeting strategies (formal, casual, time-of-day...);
+// use cases depend on this interface so formatting can change without
+// touching orchestration code.
+//
+// Contract:
+//   - p is a valid Person (created via a smart constructor)
+//   - Greet is pure: same Person, same greeting; must not panic
+type GreetingService interface {
+	Greet(p valueobject.Person) string
+}
+
+// PunctuatedGreetingService is a GreetingService that can also end its
+// greeting in caller-chosen punctuation (--punctuation). Strategies that
+// only implement GreetingService cannot honour a non-default punctuation.
+//
+// Contract:
+//   - GreetWithPunctuation(p, valueobject.DefaultPunctuation) equals
+//     Ok(Greet(p))
+//   - Returns Err(ValidationError) for punctuation the domain rejects
+//     (see Person.GreetingMessageWithPunctuation)
+type PunctuatedGreetingService interface {
+	GreetingService
+	GreetWithPunctuation(p valueobject.Person, punctuation string) domerr.Result[string]
+}
+
+// DefaultGreetingService is the canonical strategy: it delegates to
+// Person.GreetingMessage ("Hello, Alice!").
+//
+// Implements: PunctuatedGreetingService
+type DefaultGreetingService struct{}
+
+// Greet returns p.GreetingMessage().
+func (DefaultGreetingService) Greet(p valueobject.Person) string {
+	return p.GreetingMessage()
+}
+
+// GreetWithPunctuation returns p.GreetingMessageWithPunctuation(punctuation).
+func (DefaultGreetingService) GreetWithPunctuation(p valueobject.Person, punctuation string) domerr.Result[string] {
+	return p.GreetingMessageWithPunctuation(punctuation)
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package service_test
+
+import (
+	"testing"
+
+	"github.com/abitofhelp/hybrid_app_go/domain/service"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/domain/valueobject"
+)
+
+// formalGreeting is a custom strategy used to show services are swappable.
+type formalGreeting struct{}
+
+func (formalGreeting) Greet(p valueobject.Person) string {
+	return "Good day, " + p.GetName() + "."
+}
+
+// TestDomainServiceGreeting tests the greeting strategies.
+func TestDomainServiceGreeting(t *testing.T) {
+	tf := test.New("Domain.Service.Greeting")
+	alice := valueobject.CreatePerson("Alice").Value()
+
+	// ========================================================================
+	// Test: Default strategy matches Person.GreetingMessage
+	// ========================================================================
+
+	var greeter service.GreetingService = service.DefaultGreetingService{}
+	tf.AssertEqual("Default - Hello greeting", greeter.Greet(alice), "Hello, Alice!")
+	tf.AssertEqual("Default - delegates to Person", greeter.Greet(alice), alice.GreetingMessage())
+
+	// ========================================================================
+	// Test: Custom strategy is interchangeable
+	// ========================================================================
+
+	greeter = formalGreeting{}
+	tf.AssertEqual("Custom - formal greeting", greeter.Greet(alice), "Good day, Alice.")
+
+	// ========================================================================
+	// Test: Default strategy supports punctuation; a plain strategy does not
+	// ========================================================================
+
+	var punctuated service.PunctuatedGreetingService = service.DefaultGreetingService{}
+	tf.AssertEqual("Punctuated - period", punctuated.GreetWithPunctuation(alice, ".").Value(), "Hello, Alice.")
+	tf.AssertEqual("Punctuated - default equals Greet",
+		punctuated.GreetWithPunctuation(alice, valueobject.DefaultPunctuation).Value(), punctuated.Greet(alice))
+	tf.RunTest("Punctuated - invalid rejected", punctuated.GreetWithPunctuation(alice, "!!!").IsError())
+
+	_, ok := greeter.(service.PunctuatedGreetingService)
+	tf.RunTest("Punctuated - formal strategy does not implement it", !ok)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package test
+
+import (
+	"encoding/xml"
+	"io"
+)
+
+// caseRecord is a single recorded test outcome.
+type caseRecord struct {
+	suite  string
+	name   string
+	passed bool
+	detail string
+}
+
+// cases holds every test registered since the last Reset (guarded by mu).
+// A Framework's tests are registered by its summary, together with its
+// counts, so the report always agrees with the grand totals.
+var cases []caseRecord
+
+// JUnit XML document structure (subset understood by common CI systems).
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+}
+
+// WriteJUnitReport writes every test registered since the last Reset (by
+// Summary or SummaryNoFail) as a JUnit XML document; tests of a Framework
+// whose summary is never printed are not included. Each Framework (module name) becomes a <testsuite>,
+// in order of first appearance; each RunTest/Assert* call becomes a
+// <testcase>, with a <failure> element when it did not pass.
+//
+// Usage (from TestMain, after m.Run()):
+//
+//	code := m.Run()
+//	if f, err := os.Create("junit.xml"); err == nil {
+//	    _ = test.WriteJUnitReport(f)
+//	    f.Close()
+//	}
+func WriteJUnitReport(w io.Writer) error {
+	mu.Lock()
+	snapshot := append([]caseRecord(nil), cases...)
+	mu.Unlock()
+
+	doc := junitTestSuites{}
+	index := map[string]int{}
+	for _, c := range snapshot {
+		i, ok := index[c.suite]
+		if !ok {
+			i = len(doc.Suites)
+			index[c.suite] = i
+			doc.Suites = append(doc.Suites, junitTestSuite{Name: c.suite})
+		}
+
+		tc := junitTestCase{Name: c.name, ClassName: c.suite}
+		if !c.passed {
+			message := c.detail
+			if message == "" {
+				message = "assertion failed"
+			}
+			tc.Failure = &junitFailure{Message: message}
+			doc.Suites[i].Failures++
+			doc.Failures++
+		}
+		doc.Suites[i].Cases = append(doc.Suites[i].Cases, tc)
+		doc.Suites[i].Tests++
+		doc.Tests++
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// TestDomainTestFrameworkJUnitFailures tests how failing cases are
+// rendered. The global registry is swapped for a fixed set of cases and
+// restored afterwards, so no failure is registered into this run.
+func TestDomainTestFrameworkJUnitFailures(t *testing.T) {
+	tf := New("Domain.Test.Framework.JUnit.Failures")
+
+	mu.Lock()
+	saved := cases
+	cases = []caseRecord{
+		{suite: "Suite.A", name: "passes", passed: true},
+		{suite: "Suite.A", name: "fails with detail", detail: "got 1, want 2"},
+		{suite: "Suite.B", name: "fails without detail"},
+	}
+	mu.Unlock()
+
+	var buf bytes.Buffer
+	err := WriteJUnitReport(&buf)
+
+	mu.Lock()
+	cases = saved
+	mu.Unlock()
+
+	report := buf.String()
+	tf.RunTestWithError("WriteJUnitReport - returns no error", err)
+	tf.RunTest("Totals - tests and failures",
+		strings.Contains(report, `<testsuites tests="3" failures="2">`))
+	tf.RunTest("Suite A - one of two failed",
+		strings.Contains(report, `<testsuite name="Suite.A" tests="2" failures="1">`))
+	tf.RunTest("Failure - detail is the message",
+		strings.Contains(report, `<failure message="got 1, want 2"></failure>`))
+	tf.RunTest("Failure - default message without detail",
+		strings.Contains(report, `<failure message="assertion failed"></failure>`))
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
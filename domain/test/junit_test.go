@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package test_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+)
+
+// junitDoc mirrors the report structure for decoding in tests.
+type junitDoc struct {
+	Tests    int `xml:"tests,attr"`
+	Failures int `xml:"failures,attr"`
+	Suites   []struct {
+		Name     string `xml:"name,attr"`
+		Tests    int    `xml:"tests,attr"`
+		Failures int    `xml:"failures,attr"`
+		Cases    []struct {
+			Name    string `xml:"name,attr"`
+			Failure *struct {
+				Message string `xml:"message,attr"`
+			} `xml:"failure"`
+		} `xml:"testcase"`
+	} `xml:"testsuite"`
+}
+
+// TestDomainTestFrameworkJUnitReport tests JUnit XML serialization of the
+// registered tests (failure elements are covered by junit_internal_test.go,
+// which does not register failures into this run's totals).
+func TestDomainTestFrameworkJUnitReport(t *testing.T) {
+	tf := test.New("Domain.Test.Framework.JUnit")
+
+	// Register a known set of outcomes on a dedicated (uniquely named) suite
+	const suiteName = "Domain.Test.Framework.JUnit.Recorded"
+	recorded := test.NewWithOutput(suiteName, io.Discard)
+	recorded.RunTest("first passes", true)
+	recorded.AssertEqual("second passes", 1, 1)
+	recorded.RunTest("third passes", true)
+
+	// Report and totals before this suite registers, for the deltas below
+	var before bytes.Buffer
+	_ = test.WriteJUnitReport(&before)
+	var docBefore junitDoc
+	_ = xml.Unmarshal(before.Bytes(), &docBefore)
+	totalBefore, passedBefore := test.GrandTotalTests(), test.GrandTotalPassed()
+
+	recorded.SummaryNoFail()
+
+	// A scratch framework whose summary is never printed is not reported
+	const scratchName = "Domain.Test.Framework.JUnit.Scratch"
+	scratch := test.NewWithOutput(scratchName, io.Discard)
+	scratch.AssertEqual("deliberately fails", 1, 2)
+
+	var buf bytes.Buffer
+	err := test.WriteJUnitReport(&buf)
+	tf.RunTest("WriteJUnitReport - returns no error", err == nil)
+	tf.RunTest("WriteJUnitReport - starts with XML header",
+		strings.HasPrefix(buf.String(), "<?xml"))
+
+	var doc junitDoc
+	tf.RunTest("WriteJUnitReport - output is valid XML",
+		xml.Unmarshal(buf.Bytes(), &doc) == nil)
+
+	found := false
+	for _, suite := range doc.Suites {
+		tf.RunTest("Scratch suite - not reported ("+suite.Name+")", suite.Name != scratchName)
+		if suite.Name != suiteName {
+			continue
+		}
+		found = true
+		tf.AssertEqual("Recorded suite - tests attribute", suite.Tests, 3)
+		tf.AssertEqual("Recorded suite - failures attribute", suite.Failures, 0)
+		tf.AssertEqual("Recorded suite - testcase element count", len(suite.Cases), 3)
+	}
+	tf.RunTest("WriteJUnitReport - recorded suite present", found)
+
+	// ========================================================================
+	// Test: The report grows with the grand totals
+	// ========================================================================
+
+	// Other tests may have registered counts before this one (including
+	// RegisterResults counts, which have no cases), so compare deltas
+	tf.AssertEqual("Totals - tests grow with GrandTotalTests",
+		doc.Tests-docBefore.Tests, test.GrandTotalTests()-totalBefore)
+	tf.AssertEqual("Totals - passing grows with GrandTotalPassed",
+		(doc.Tests-doc.Failures)-(docBefore.Tests-docBefore.Failures), test.GrandTotalPassed()-passedBefore)
+	tf.AssertEqual("Totals - delta is the recorded suite", doc.Tests-docBefore.Tests, 3)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
@@ -20,10 +20,21 @@
 //	    tf.RunTest("Ok construction - Is_Ok returns true", result.IsOk())
 //	    tf.RunTest("Ok value extraction", value == 42)
 //
+//	    // Assertions print expected-vs-actual on failure
+//	    tf.AssertEqual("Ok value", result.Value(), 42)
+//	    tf.AssertError("Empty name rejected", CreatePerson(""), domerr.ValidationError)
+//
+//	    // Timed tests are listed in the summary, slowest first
+//	    tf.RunTimed("Batch of 1000 greetings", func() bool { return greetAll(names) })
+//
 //	    // Print summary and fail if any tests failed
 //	    tf.Summary(t)
 //	}
 //
+// Concurrency: A Framework returned by New is safe for concurrent use, so
+// subtests calling t.Parallel() (or goroutines) may share one instance.
+// The grand-total counters are likewise guarded for parallel test files.
+//
 // For test runners that aggregate multiple test modules:
 //
 //	func TestMain(m *testing.M) {
@@ -34,12 +45,25 @@
 //	        test.GrandTotalPassed())
 //	    os.Exit(code)
 //	}
+//
+// CategorySummary returns the same counts as a CategoryResult struct, for
+// runners that act on the results or serialize them instead of printing.
+//
+// For CI systems, every recorded test can also be emitted as JUnit XML
+// from TestMain after m.Run() (see WriteJUnitReport in junit.go).
 package test
 
 import (
 	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
 	"sync"
 	"testing"
+	"time"
+
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
 )
 
 // ANSI color codes for professional output.
@@ -57,21 +81,56 @@ var (
 )
 
 // Framework tracks test results for a single test module.
+// All methods are safe for concurrent use.
 type Framework struct {
-	name   string
-	total  int
-	passed int
+	name     string
+	out      io.Writer
+	mu       sync.Mutex
+	total    int
+	passed   int
+	failures []string
+	timings  []Timing
+	cases    []caseRecord
+}
+
+// Timing is the elapsed time of one test run with RunTimed.
+type Timing struct {
+	Name    string
+	Elapsed time.Duration
 }
 
-// New creates a new test framework instance for a test module.
+// ErrorResult is satisfied by every domerr.Result[T], letting AssertError
+// accept a Result of any value type (Go methods cannot be generic).
+type ErrorResult interface {
+	IsError() bool
+	ErrorInfo() domerr.ErrorType
+}
+
+// New creates a new test framework instance for a test module that prints
+// to os.Stdout. The returned Framework is concurrency-safe.
 func New(moduleName string) *Framework {
-	fmt.Println("========================================")
-	fmt.Printf("Testing: %s\n", moduleName)
-	fmt.Println("========================================")
-	fmt.Println()
+	return NewWithOutput(moduleName, stdout{})
+}
+
+// stdout writes to whatever os.Stdout is at the time of the write.
+type stdout struct{}
+
+func (stdout) Write(p []byte) (int, error) {
+	return os.Stdout.Write(p)
+}
+
+// NewWithOutput is New printing to out instead of os.Stdout. Pass
+// io.Discard for a scratch Framework whose deliberate failures should not
+// clutter the test output, or a buffer to assert on what is printed.
+func NewWithOutput(moduleName string, out io.Writer) *Framework {
+	fmt.Fprintln(out, "========================================")
+	fmt.Fprintf(out, "Testing: %s\n", moduleName)
+	fmt.Fprintln(out, "========================================")
+	fmt.Fprintln(out)
 
 	return &Framework{
 		name:   moduleName,
+		out:    out,
 		total:  0,
 		passed: 0,
 	}
@@ -80,81 +139,178 @@ func New(moduleName string) *Framework {
 // RunTest executes a single test and records the result.
 // Prints [PASS] (green) or [FAIL] (red) with the test name.
 func (f *Framework) RunTest(name string, passed bool) {
-	f.total++
 	if passed {
-		f.passed++
-		fmt.Printf("%s[PASS]%s %s\n", ColorGreen, ColorReset, name)
+		f.pass(name)
 	} else {
-		fmt.Printf("%s[FAIL]%s %s\n", ColorRed, ColorReset, name)
+		f.fail(name, "")
 	}
 }
 
+// RunTimed runs fn, records its result like RunTest, and records how long
+// it took. Timed tests are listed in the summary, slowest first, to help
+// spot slow tests. Returns the elapsed time.
+func (f *Framework) RunTimed(name string, fn func() bool) time.Duration {
+	start := time.Now()
+	passed := fn()
+	elapsed := time.Since(start)
+
+	f.mu.Lock()
+	f.timings = append(f.timings, Timing{Name: name, Elapsed: elapsed})
+	f.mu.Unlock()
+
+	f.RunTest(name, passed)
+	return elapsed
+}
+
 // RunTestWithError executes a test that may return an error.
 // The test passes if err is nil, fails otherwise.
 func (f *Framework) RunTestWithError(name string, err error) {
-	f.total++
 	if err == nil {
-		f.passed++
-		fmt.Printf("%s[PASS]%s %s\n", ColorGreen, ColorReset, name)
+		f.pass(name)
 	} else {
-		fmt.Printf("%s[FAIL]%s %s: %v\n", ColorRed, ColorReset, name, err)
+		f.fail(name, err.Error())
 	}
 }
 
+// AssertEqual records a test that passes if got and want are deeply equal.
+// On failure, prints both values so the mismatch is visible in the output.
+func (f *Framework) AssertEqual(name string, got, want any) {
+	if reflect.DeepEqual(got, want) {
+		f.pass(name)
+		return
+	}
+	f.fail(name, fmt.Sprintf("got %#v, want %#v", got, want))
+}
+
+// AssertError records a test that passes if r is an error of kind wantKind.
+// On failure, prints the actual state (Ok, or the error's kind and message).
+func (f *Framework) AssertError(name string, r ErrorResult, wantKind domerr.ErrorKind) {
+	if !r.IsError() {
+		f.fail(name, fmt.Sprintf("got Ok, want Err(%s)", wantKind))
+		return
+	}
+	info := r.ErrorInfo()
+	if info.Kind != wantKind {
+		f.fail(name, fmt.Sprintf("got Err(%s: %q), want Err(%s)", info.Kind, info.Message, wantKind))
+		return
+	}
+	f.pass(name)
+}
+
+// Failures returns the failure messages recorded by this module, in order.
+// Each entry is "<test name>: <detail>" (detail is empty for RunTest).
+func (f *Framework) Failures() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.failures...)
+}
+
+// Timings returns the timings recorded by RunTimed, in run order.
+func (f *Framework) Timings() []Timing {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]Timing(nil), f.timings...)
+}
+
+// pass records and prints a passing test.
+func (f *Framework) pass(name string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.total++
+	f.passed++
+	f.cases = append(f.cases, caseRecord{suite: f.name, name: name, passed: true})
+	fmt.Fprintf(f.out, "%s[PASS]%s %s\n", ColorGreen, ColorReset, name)
+}
+
+// fail records and prints a failing test with optional detail.
+func (f *Framework) fail(name, detail string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.total++
+	f.cases = append(f.cases, caseRecord{suite: f.name, name: name, detail: detail})
+	if detail == "" {
+		f.failures = append(f.failures, name)
+		fmt.Fprintf(f.out, "%s[FAIL]%s %s\n", ColorRed, ColorReset, name)
+		return
+	}
+	f.failures = append(f.failures, name+": "+detail)
+	fmt.Fprintf(f.out, "%s[FAIL]%s %s: %s\n", ColorRed, ColorReset, name, detail)
+}
+
 // Total returns the total number of tests run in this module.
 func (f *Framework) Total() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
 	return f.total
 }
 
 // Passed returns the number of tests that passed in this module.
 func (f *Framework) Passed() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
 	return f.passed
 }
 
 // Failed returns the number of tests that failed in this module.
 func (f *Framework) Failed() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
 	return f.total - f.passed
 }
 
 // Summary prints the test summary for this module and registers results.
 // If any tests failed, it calls t.Fail() to mark the test as failed.
 func (f *Framework) Summary(t *testing.T) {
-	fmt.Println()
-	fmt.Println("========================================")
-	fmt.Printf("Test Summary: %s\n", f.name)
-	fmt.Println("========================================")
-	fmt.Printf("Total tests: %d\n", f.total)
-	fmt.Printf("Passed:      %d\n", f.passed)
-	fmt.Printf("Failed:      %d\n", f.total-f.passed)
-	fmt.Println()
-
-	// Register results with global counters
-	RegisterResults(f.total, f.passed)
+	total, passed := f.SummaryNoFail()
 
 	// Fail the Go test if any tests failed
-	if f.passed != f.total {
+	if passed != total {
 		t.Fail()
 	}
 }
 
 // SummaryNoFail prints the test summary without failing the Go test.
+// Tests run with RunTimed are listed with their elapsed times.
 // Use this for informational output when you want to aggregate results.
-func (f *Framework) SummaryNoFail() {
-	fmt.Println()
-	fmt.Println("========================================")
-	fmt.Printf("Test Summary: %s\n", f.name)
-	fmt.Println("========================================")
-	fmt.Printf("Total tests: %d\n", f.total)
-	fmt.Printf("Passed:      %d\n", f.passed)
-	fmt.Printf("Failed:      %d\n", f.total-f.passed)
-	fmt.Println()
+// Returns the total and passed counts that were printed and registered.
+func (f *Framework) SummaryNoFail() (total, passed int) {
+	f.mu.Lock()
+	total, passed = f.total, f.passed
+	timings := append([]Timing(nil), f.timings...)
+	recorded := append([]caseRecord(nil), f.cases...)
+	f.mu.Unlock()
+
+	fmt.Fprintln(f.out)
+	fmt.Fprintln(f.out, "========================================")
+	fmt.Fprintf(f.out, "Test Summary: %s\n", f.name)
+	fmt.Fprintln(f.out, "========================================")
+	fmt.Fprintf(f.out, "Total tests: %d\n", total)
+	fmt.Fprintf(f.out, "Passed:      %d\n", passed)
+	fmt.Fprintf(f.out, "Failed:      %d\n", total-passed)
+	if len(timings) > 0 {
+		// Slowest first; ties keep run order
+		sort.SliceStable(timings, func(i, j int) bool {
+			return timings[i].Elapsed > timings[j].Elapsed
+		})
+		fmt.Fprintln(f.out, "Timed tests (slowest first):")
+		for _, timing := range timings {
+			fmt.Fprintf(f.out, "  %10s  %s\n", timing.Elapsed.Round(time.Microsecond), timing.Name)
+		}
+	}
+	fmt.Fprintln(f.out)
 
-	// Register results with global counters
-	RegisterResults(f.total, f.passed)
+	// Register results (and the cases for WriteJUnitReport) globally
+	mu.Lock()
+	totalTests += total
+	totalPassed += passed
+	cases = append(cases, recorded...)
+	mu.Unlock()
+	return total, passed
 }
 
 // RegisterResults adds test results to the global counters.
-// Thread-safe for parallel test execution.
+// Thread-safe for parallel test execution. Counts registered this way have
+// no individual cases, so they do not appear in WriteJUnitReport.
 func RegisterResults(total, passed int) {
 	mu.Lock()
 	defer mu.Unlock()
@@ -176,13 +332,46 @@ func GrandTotalPassed() int {
 	return totalPassed
 }
 
-// Reset clears the global test counters.
+// Reset clears the global test counters and recorded test cases.
 // Call this at the start of a test runner.
 func Reset() {
 	mu.Lock()
 	defer mu.Unlock()
 	totalTests = 0
 	totalPassed = 0
+	cases = nil
+}
+
+// CategoryResult is the outcome of a test category (e.g., "UNIT TESTS"),
+// for callers that act on results programmatically or serialize them.
+type CategoryResult struct {
+	Name   string `json:"name"`
+	Total  int    `json:"total"`
+	Passed int    `json:"passed"`
+	Failed int    `json:"failed"`
+}
+
+// CategorySummary returns the grand totals registered so far (see
+// RegisterResults) as the result of categoryName.
+//
+// Usage (in TestMain, after m.Run()):
+//
+//	summary := test.CategorySummary("UNIT TESTS")
+//	json.NewEncoder(f).Encode(summary) // {"name":"UNIT TESTS","total":42,...}
+func CategorySummary(categoryName string) CategoryResult {
+	mu.Lock()
+	defer mu.Unlock()
+	return newCategoryResult(categoryName, totalTests, totalPassed)
+}
+
+// newCategoryResult builds a CategoryResult from total and passed counts.
+func newCategoryResult(categoryName string, total, passed int) CategoryResult {
+	return CategoryResult{
+		Name:   categoryName,
+		Total:  total,
+		Passed: passed,
+		Failed: total - passed,
+	}
 }
 
 // PrintCategorySummary prints a professional color-coded summary banner.
@@ -206,14 +395,15 @@ func Reset() {
 //	###                                  ###
 //	########################################
 func PrintCategorySummary(categoryName string, total, passed int) int {
+	summary := newCategoryResult(categoryName, total, passed)
 	fmt.Println()
 
-	if passed == total {
+	if summary.Failed == 0 {
 		// Success: Bright green box
 		fmt.Println(ColorGreen + "########################################")
 		fmt.Println("###                                  ###")
-		fmt.Printf("###    %s: SUCCESS\n", categoryName)
-		fmt.Printf("###    All %d tests passed!\n", total)
+		fmt.Printf("###    %s: SUCCESS\n", summary.Name)
+		fmt.Printf("###    All %d tests passed!\n", summary.Total)
 		fmt.Println("###                                  ###")
 		fmt.Println("########################################" + ColorReset)
 		fmt.Println()
@@ -223,8 +413,8 @@ func PrintCategorySummary(categoryName string, total, passed int) int {
 	// Failure: Bright red box
 	fmt.Println(ColorRed + "########################################")
 	fmt.Println("###                                  ###")
-	fmt.Printf("###    %s: FAILURE\n", categoryName)
-	fmt.Printf("###    %d of %d tests failed\n", total-passed, total)
+	fmt.Printf("###    %s: FAILURE\n", summary.Name)
+	fmt.Printf("###    %d of %d tests failed\n", summary.Failed, summary.Total)
 	fmt.Println("###                                  ###")
 	fmt.Println("########################################" + ColorReset)
 	fmt.Println()
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package test
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+)
+
+// isolateGlobals empties the global counters and recorded cases, and
+// returns a function that puts the saved values back. Call it before the
+// Framework's summary, so only this test's own results stay registered.
+func isolateGlobals() (restore func()) {
+	mu.Lock()
+	savedTotal, savedPassed, savedCases := totalTests, totalPassed, cases
+	totalTests, totalPassed, cases = 0, 0, nil
+	mu.Unlock()
+	return func() {
+		mu.Lock()
+		totalTests, totalPassed, cases = savedTotal, savedPassed, savedCases
+		mu.Unlock()
+	}
+}
+
+// TestDomainTestFrameworkGrandTotalsConcurrency tests that concurrent
+// summaries and registrations keep exact grand totals. It runs against
+// emptied globals and restores them, so the totals of this run are
+// unchanged.
+func TestDomainTestFrameworkGrandTotalsConcurrency(t *testing.T) {
+	tf := New("Domain.Test.Framework.GrandTotalsConcurrency")
+
+	const goroutines = 8
+	const perGoroutine = 50
+
+	restore := isolateGlobals()
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(2)
+		go func(g int) {
+			defer wg.Done()
+			module := NewWithOutput(fmt.Sprintf("Domain.Test.Framework.Concurrency.Module%d", g), io.Discard)
+			for i := 0; i < perGoroutine; i++ {
+				module.RunTest("concurrent", i%2 == 0)
+			}
+			module.SummaryNoFail()
+		}(g)
+		go func() {
+			defer wg.Done()
+			RegisterResults(1, 1)
+		}()
+	}
+	wg.Wait()
+	gotTotal, gotPassed := GrandTotalTests(), GrandTotalPassed()
+	mu.Lock()
+	gotCases := len(cases)
+	mu.Unlock()
+	restore()
+
+	tf.AssertEqual("Concurrent summaries - GrandTotalTests is exact",
+		gotTotal, goroutines*(perGoroutine+1))
+	tf.AssertEqual("Concurrent summaries - GrandTotalPassed is exact",
+		gotPassed, goroutines*(perGoroutine/2+1))
+	tf.AssertEqual("Concurrent summaries - every case recorded",
+		gotCases, goroutines*perGoroutine)
+
+	// ========================================================================
+	// Test: Restoring leaves the saved totals untouched
+	// ========================================================================
+
+	before := GrandTotalTests()
+	undo := isolateGlobals()
+	RegisterResults(5, 5)
+	undo()
+	tf.AssertEqual("Restore - totals unchanged", GrandTotalTests(), before)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
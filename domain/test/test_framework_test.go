@@ -0,0 +1,209 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package test_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+)
+
+// TestDomainTestFrameworkAssertions tests the AssertEqual/AssertError API.
+// Failing assertions are exercised on separate, silent Frameworks whose
+// summaries are never registered, so they don't fail this test run.
+func TestDomainTestFrameworkAssertions(t *testing.T) {
+	tf := test.New("Domain.Test.Framework.Assertions")
+
+	// ========================================================================
+	// Test: Passing assertions are counted as passed
+	// ========================================================================
+
+	inner := test.NewWithOutput("Domain.Test.Framework.Assertions.Inner", io.Discard)
+	inner.AssertEqual("equal ints", 42, 42)
+	inner.AssertEqual("equal slices", []string{"a", "b"}, []string{"a", "b"})
+	inner.AssertError("validation error", domerr.Err[int](domerr.NewValidationError("bad")),
+		domerr.ValidationError)
+	tf.RunTest("Passing assertions - all counted", inner.Total() == 3)
+	tf.RunTest("Passing assertions - all passed", inner.Passed() == 3)
+	tf.RunTest("Passing assertions - no failures recorded", len(inner.Failures()) == 0)
+
+	// ========================================================================
+	// Test: AssertEqual failure message includes got and want
+	// ========================================================================
+
+	failing := test.NewWithOutput("Domain.Test.Framework.Assertions.Failing", io.Discard)
+	failing.AssertEqual("greeting", "Hello, Bob!", "Hello, Alice!")
+	failures := failing.Failures()
+	tf.RunTest("AssertEqual failure - counted as failed", failing.Failed() == 1)
+	tf.RunTest("AssertEqual failure - one message recorded", len(failures) == 1)
+	if len(failures) == 1 {
+		tf.RunTest("AssertEqual failure - message names the test",
+			strings.HasPrefix(failures[0], "greeting: "))
+		tf.RunTest("AssertEqual failure - message includes got value",
+			strings.Contains(failures[0], `got "Hello, Bob!"`))
+		tf.RunTest("AssertEqual failure - message includes want value",
+			strings.Contains(failures[0], `want "Hello, Alice!"`))
+	}
+
+	// ========================================================================
+	// Test: AssertError failure messages describe actual state
+	// ========================================================================
+
+	failing = test.NewWithOutput("Domain.Test.Framework.Assertions.FailingError", io.Discard)
+	failing.AssertError("ok instead of error", domerr.Ok("x"), domerr.ValidationError)
+	failing.AssertError("wrong kind",
+		domerr.Err[string](domerr.NewInfrastructureError("disk full")), domerr.ValidationError)
+	failures = failing.Failures()
+	tf.RunTest("AssertError failure - both counted as failed", failing.Failed() == 2)
+	if len(failures) == 2 {
+		tf.RunTest("AssertError on Ok - message shows got Ok and wanted kind",
+			strings.Contains(failures[0], "got Ok, want Err(ValidationError)"))
+		tf.RunTest("AssertError wrong kind - message shows actual kind and message",
+			strings.Contains(failures[1], `got Err(InfrastructureError: "disk full")`))
+		tf.RunTest("AssertError wrong kind - message shows wanted kind",
+			strings.Contains(failures[1], "want Err(ValidationError)"))
+	} else {
+		tf.RunTest("AssertError failure - two messages recorded", false)
+	}
+
+	// ========================================================================
+	// Test: RunTest keeps working alongside assertions
+	// ========================================================================
+
+	mixed := test.NewWithOutput("Domain.Test.Framework.Assertions.Mixed", io.Discard)
+	mixed.RunTest("plain pass", true)
+	mixed.RunTest("plain fail", false)
+	mixed.AssertEqual("assert pass", 1, 1)
+	tf.RunTest("RunTest compatibility - totals combined", mixed.Total() == 3)
+	tf.RunTest("RunTest compatibility - passed combined", mixed.Passed() == 2)
+	tf.RunTest("RunTest compatibility - failure recorded by name",
+		len(mixed.Failures()) == 1 && mixed.Failures()[0] == "plain fail")
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
+
+// TestDomainTestFrameworkConcurrency stress-tests a shared Framework from
+// many goroutines and verifies the counts are exact.
+func TestDomainTestFrameworkConcurrency(t *testing.T) {
+	tf := test.New("Domain.Test.Framework.Concurrency")
+
+	const goroutines = 8
+	const perGoroutine = 50
+
+	shared := test.NewWithOutput("Domain.Test.Framework.Concurrency.Shared", io.Discard)
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perGoroutine; i++ {
+				// Alternate pass/fail so both counters are exercised
+				shared.RunTest("concurrent", i%2 == 0)
+			}
+		}()
+	}
+	wg.Wait()
+
+	tf.RunTest("Concurrent RunTest - total is exact",
+		shared.Total() == goroutines*perGoroutine)
+	tf.RunTest("Concurrent RunTest - passed is exact",
+		shared.Passed() == goroutines*perGoroutine/2)
+	tf.RunTest("Concurrent RunTest - failures recorded exactly",
+		len(shared.Failures()) == goroutines*perGoroutine/2)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
+
+// TestDomainTestFrameworkCategorySummary tests the structured category
+// summary. Only passing tests are registered, so the final banner of this
+// run stays green.
+func TestDomainTestFrameworkCategorySummary(t *testing.T) {
+	tf := test.New("Domain.Test.Framework.CategorySummary")
+
+	// ========================================================================
+	// Test: Registered RunTest results are reflected in the struct
+	// ========================================================================
+
+	before := test.CategorySummary("UNIT TESTS")
+	tf.AssertEqual("Before - failed is total minus passed", before.Failed, before.Total-before.Passed)
+
+	recorded := test.NewWithOutput("Domain.Test.Framework.CategorySummary.Recorded", io.Discard)
+	recorded.RunTest("first", true)
+	recorded.RunTest("second", true)
+	recorded.RunTest("third", true)
+	recorded.SummaryNoFail()
+
+	after := test.CategorySummary("UNIT TESTS")
+	tf.AssertEqual("After - counts include recorded module", after, test.CategoryResult{
+		Name:   "UNIT TESTS",
+		Total:  before.Total + 3,
+		Passed: before.Passed + 3,
+		Failed: before.Failed,
+	})
+	tf.AssertEqual("After - matches grand totals",
+		[]int{after.Total, after.Passed}, []int{test.GrandTotalTests(), test.GrandTotalPassed()})
+
+	// ========================================================================
+	// Test: The struct serializes with lower-case field names
+	// ========================================================================
+
+	data, err := json.Marshal(test.CategoryResult{Name: "E2E TESTS", Total: 5, Passed: 4, Failed: 1})
+	tf.RunTestWithError("JSON - marshals", err)
+	tf.AssertEqual("JSON - field names", string(data),
+		`{"name":"E2E TESTS","total":5,"passed":4,"failed":1}`)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
+
+// TestDomainTestFrameworkRunTimed tests per-test duration measurement.
+func TestDomainTestFrameworkRunTimed(t *testing.T) {
+	tf := test.New("Domain.Test.Framework.RunTimed")
+
+	// ========================================================================
+	// Test: Elapsed time is at least the work done and is recorded
+	// ========================================================================
+
+	const sleep = 20 * time.Millisecond
+	var out bytes.Buffer
+	timed := test.NewWithOutput("Domain.Test.Framework.RunTimed.Recorded", &out)
+	timed.RunTest("untimed", true)
+	fast := timed.RunTimed("fast", func() bool { return true })
+	elapsed := timed.RunTimed("sleeps", func() bool {
+		time.Sleep(sleep)
+		return true
+	})
+	tf.RunTest("RunTimed - elapsed at least the sleep", elapsed >= sleep)
+	tf.AssertEqual("RunTimed - counted like RunTest", []int{timed.Total(), timed.Passed()}, []int{3, 3})
+	tf.AssertEqual("RunTimed - timings in run order", timed.Timings(), []test.Timing{
+		{Name: "fast", Elapsed: fast},
+		{Name: "sleeps", Elapsed: elapsed},
+	})
+
+	// ========================================================================
+	// Test: Summary lists timed tests, slowest first
+	// ========================================================================
+
+	out.Reset()
+	timed.SummaryNoFail()
+	summary := out.String()
+	slowLine := "  " + elapsed.Round(time.Microsecond).String() + "  sleeps\n"
+	tf.RunTest("Summary - has timing section", strings.Contains(summary, "Timed tests (slowest first):\n"))
+	tf.RunTest("Summary - lists the sleeping test with its duration", strings.Contains(summary, slowLine))
+	tf.RunTest("Summary - slowest listed first",
+		strings.Index(summary, "  sleeps\n") < strings.Index(summary, "  fast\n"))
+	tf.RunTest("Summary - untimed test not listed", !strings.Contains(summary, "  untimed\n"))
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: valueobject
+// Description: Locale value object and built-in greeting table
+
+package valueobject
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+// Locale identifies a supported greeting language by its ISO 639-1 code.
+//
+// Design Pattern: Value Object
+//   - Immutable after creation
+//   - Only supported codes can be created via CreateLocale
+//   - Comparable with == (single string field)
+type Locale struct {
+	code string
+}
+
+// Supported locales.
+var (
+	// LocaleEnglish greets with "Hello, <name>!" (the default greeting).
+	LocaleEnglish = Locale{code: "en"}
+
+	// LocaleSpanish greets with "¡Hola, <name>!".
+	LocaleSpanish = Locale{code: "es"}
+
+	// LocaleFrench greets with "Bonjour, <name> !".
+	LocaleFrench = Locale{code: "fr"}
+)
+
+// greetingTemplate frames the name for one locale.
+type greetingTemplate struct {
+	prefix string
+	suffix string
+}
+
+// greetingTemplates is the built-in greeting table, keyed by locale code.
+var greetingTemplates = map[string]greetingTemplate{
+	LocaleEnglish.code: {prefix: greetingPrefix, suffix: greetingSuffix},
+	LocaleSpanish.code: {prefix: "¡Hola, ", suffix: "!"},
+	LocaleFrench.code:  {prefix: "Bonjour, ", suffix: " !"},
+}
+
+// CreateLocale creates a Locale from a language code such as "en" or "ES".
+//
+// The code is matched case-insensitively after trimming surrounding space.
+//
+// Contract:
+//   - Post: Returns Ok(Locale) if the code is in the built-in table
+//   - Post: Returns Err(ValidationError) listing supported codes otherwise
+func CreateLocale(code string) domerr.Result[Locale] {
+	normalized := strings.ToLower(strings.TrimSpace(code))
+	if _, ok := greetingTemplates[normalized]; !ok {
+		return domerr.Err[Locale](domerr.NewValidationError(
+			fmt.Sprintf("unsupported locale %q (supported: %s)",
+				code, strings.Join(supportedLocaleCodes(), ", "))))
+	}
+	return domerr.Ok(Locale{code: normalized})
+}
+
+// SupportedLocales returns every supported Locale, sorted by code.
+func SupportedLocales() []Locale {
+	codes := supportedLocaleCodes()
+	locales := make([]Locale, len(codes))
+	for i, code := range codes {
+		locales[i] = Locale{code: code}
+	}
+	return locales
+}
+
+// Code returns the ISO 639-1 language code (e.g., "en").
+func (l Locale) Code() string {
+	return l.code
+}
+
+// String implements fmt.Stringer.
+func (l Locale) String() string {
+	return l.code
+}
+
+// supportedLocaleCodes returns the keys of greetingTemplates, sorted.
+func supportedLocaleCodes() []string {
+	codes := make([]string, 0, len(greetingTemplates))
+	for code := range greetingTemplates {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
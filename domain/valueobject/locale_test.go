@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package valueobject_test
+
+import (
+	"strings"
+	"testing"
+
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/domain/valueobject"
+)
+
+// TestDomainValueObjectLocale tests Locale creation and localized greetings.
+func TestDomainValueObjectLocale(t *testing.T) {
+	tf := test.New("Domain.ValueObject.Locale")
+
+	person := valueobject.CreatePerson("Alice").Value()
+
+	// ========================================================================
+	// Test: Localized greetings for each supported locale
+	// ========================================================================
+
+	cases := []struct {
+		locale   valueobject.Locale
+		expected string
+	}{
+		{valueobject.LocaleEnglish, "Hello, Alice!"},
+		{valueobject.LocaleSpanish, "¡Hola, Alice!"},
+		{valueobject.LocaleFrench, "Bonjour, Alice !"},
+	}
+	for _, tc := range cases {
+		r := person.GreetingMessageFor(tc.locale)
+		tf.RunTest("GreetingMessageFor "+tc.locale.Code()+" - IsOk", r.IsOk())
+		tf.RunTest("GreetingMessageFor "+tc.locale.Code()+" - text correct",
+			r.UnwrapOr("") == tc.expected)
+	}
+
+	tf.RunTest("GreetingMessageFor en - matches GreetingMessage",
+		person.GreetingMessageFor(valueobject.LocaleEnglish).UnwrapOr("") == person.GreetingMessage())
+
+	titled := valueobject.NewPersonBuilder().WithName("Alice").WithTitle("Dr.").Build().Value()
+	tf.RunTest("GreetingMessageFor es - includes title",
+		titled.GreetingMessageFor(valueobject.LocaleSpanish).UnwrapOr("") == "¡Hola, Dr. Alice!")
+
+	// ========================================================================
+	// Test: CreateLocale
+	// ========================================================================
+
+	r1 := valueobject.CreateLocale("ES")
+	tf.RunTest("CreateLocale - case-insensitive match",
+		r1.IsOk() && r1.Value() == valueobject.LocaleSpanish)
+
+	r2 := valueobject.CreateLocale("de")
+	tf.RunTest("CreateLocale unknown - IsError", r2.IsError())
+	if r2.IsError() {
+		info := r2.ErrorInfo()
+		tf.RunTest("CreateLocale unknown - kind is ValidationError",
+			info.Kind == domerr.ValidationError)
+		tf.RunTest("CreateLocale unknown - lists supported locales",
+			strings.Contains(info.Message, "en, es, fr"))
+	}
+
+	// ========================================================================
+	// Test: Unknown locale path (no fallback to English)
+	// ========================================================================
+
+	r3 := person.GreetingMessageFor(valueobject.Locale{})
+	tf.RunTest("GreetingMessageFor zero Locale - IsError", r3.IsError())
+	if r3.IsError() {
+		tf.RunTest("GreetingMessageFor zero Locale - kind is ValidationError",
+			r3.ErrorInfo().Kind == domerr.ValidationError)
+	}
+
+	// ========================================================================
+	// Test: SupportedLocales
+	// ========================================================================
+
+	supported := valueobject.SupportedLocales()
+	codes := make([]string, len(supported))
+	for i, l := range supported {
+		codes[i] = l.Code()
+	}
+	tf.RunTest("SupportedLocales - sorted en, es, fr",
+		strings.Join(codes, ",") == "en,es,fr")
+
+	// ========================================================================
+	// Test: Zip a validated Person with a validated Locale
+	// ========================================================================
+
+	greetIn := func(name, code string) domerr.Result[string] {
+		both := domerr.Zip(valueobject.CreatePerson(name), valueobject.CreateLocale(code))
+		return domerr.AndThenTo(both, func(p domerr.Pair[valueobject.Person, valueobject.Locale]) domerr.Result[string] {
+			return p.First.GreetingMessageFor(p.Second)
+		})
+	}
+	tf.AssertEqual("Zip - both valid", greetIn("Ana", "es").UnwrapOr(""), "¡Hola, Ana!")
+	tf.AssertEqual("Zip - invalid name reported first",
+		greetIn("", "xx").ErrorInfo().Message, valueobject.ErrMsgEmptyName)
+	tf.AssertError("Zip - invalid locale", greetIn("Ana", "xx"), domerr.ValidationError)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
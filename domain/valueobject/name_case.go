@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: valueobject
+// Description: Letter-case transforms applied to a Person's name
+
+package valueobject
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+// NameCase selects how Person.WithCase rewrites a name.
+//
+// The zero value is CaseNone.
+type NameCase int
+
+const (
+	// CaseNone leaves the name exactly as given.
+	CaseNone NameCase = iota
+	// CaseUpper upper-cases every letter ("José" -> "JOSÉ").
+	CaseUpper
+	// CaseLower lower-cases every letter ("José" -> "josé").
+	CaseLower
+	// CaseTitle upper-cases the first letter of each word and lower-cases
+	// the rest ("bob smith" -> "Bob Smith", "mary-jane" -> "Mary-Jane").
+	CaseTitle
+)
+
+// nameCaseNames are the accepted spellings, indexed by NameCase.
+var nameCaseNames = [...]string{
+	CaseNone:  "none",
+	CaseUpper: "upper",
+	CaseLower: "lower",
+	CaseTitle: "title",
+}
+
+// CreateNameCase parses "none", "upper", "lower", or "title".
+//
+// Matching is case-insensitive after trimming surrounding space; an empty
+// string means CaseNone.
+//
+// Contract:
+//   - Post: Returns Ok(NameCase) for a supported name
+//   - Post: Returns Err(ValidationError) listing supported names otherwise
+func CreateNameCase(name string) domerr.Result[NameCase] {
+	normalized := strings.ToLower(strings.TrimSpace(name))
+	if normalized == "" {
+		return domerr.Ok(CaseNone)
+	}
+	for c, n := range nameCaseNames {
+		if n == normalized {
+			return domerr.Ok(NameCase(c))
+		}
+	}
+	return domerr.Err[NameCase](domerr.NewValidationError(
+		fmt.Sprintf("unsupported case %q (supported: %s)",
+			name, strings.Join(nameCaseNames[:], ", "))))
+}
+
+// String implements fmt.Stringer.
+func (c NameCase) String() string {
+	if c < 0 || int(c) >= len(nameCaseNames) {
+		return fmt.Sprintf("NameCase(%d)", int(c))
+	}
+	return nameCaseNames[c]
+}
+
+// apply rewrites name according to c. Unknown values behave as CaseNone.
+func (c NameCase) apply(name string) string {
+	switch c {
+	case CaseUpper:
+		return strings.ToUpper(name)
+	case CaseLower:
+		return strings.ToLower(name)
+	case CaseTitle:
+		return titleCase(name)
+	default:
+		return name
+	}
+}
+
+// titleCase title-cases the first letter of each word and lower-cases the
+// rest. Words are separated by whitespace or hyphens, which are kept as is.
+func titleCase(name string) string {
+	var b strings.Builder
+	b.Grow(len(name))
+	startOfWord := true
+	for _, r := range name {
+		switch {
+		case unicode.IsSpace(r) || r == '-':
+			b.WriteRune(r)
+			startOfWord = true
+		case startOfWord:
+			b.WriteRune(unicode.ToTitle(r))
+			startOfWord = false
+		default:
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+	return b.String()
+}
+
+// WithCase returns a copy of the person whose name (and greeting) use the
+// given letter case. The title is not changed.
+//
+// Case mapping is rune-for-rune using the Unicode tables, so the number of
+// characters is unchanged, although a few letters differ in UTF-8 byte
+// length between cases ("ɐ" is 2 bytes, "Ɐ" is 3). The transformed name is
+// still validated again, against DefaultNamePolicy, so the result holds
+// every Person invariant.
+//
+// Contract:
+//   - Post: WithCase(CaseNone) returns Ok of an equal Person
+//   - Post: Returns Err(ValidationError) if the transformed name breaks a
+//     Person invariant under DefaultNamePolicy
+//   - Post: GreetingMessage() of the result uses the transformed name
+func (p Person) WithCase(c NameCase) domerr.Result[Person] {
+	return PersonBuilder{name: c.apply(p.name), title: p.title, policy: DefaultNamePolicy()}.Build()
+}
@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package valueobject_test
+
+import (
+	"strings"
+	"testing"
+
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/domain/valueobject"
+)
+
+// TestDomainValueObjectNameCase tests NameCase parsing and Person.WithCase.
+func TestDomainValueObjectNameCase(t *testing.T) {
+	tf := test.New("Domain.ValueObject.NameCase")
+
+	// ========================================================================
+	// Test: Parsing
+	// ========================================================================
+
+	parsed := []struct {
+		input string
+		want  valueobject.NameCase
+	}{
+		{"", valueobject.CaseNone},
+		{"none", valueobject.CaseNone},
+		{"upper", valueobject.CaseUpper},
+		{" Lower ", valueobject.CaseLower},
+		{"TITLE", valueobject.CaseTitle},
+	}
+	for _, tc := range parsed {
+		r := valueobject.CreateNameCase(tc.input)
+		tf.RunTest("CreateNameCase "+tc.input+" - IsOk", r.IsOk())
+		if r.IsOk() {
+			tf.AssertEqual("CreateNameCase "+tc.input+" - value", r.Value(), tc.want)
+		}
+	}
+
+	bad := valueobject.CreateNameCase("shouty")
+	tf.AssertError("CreateNameCase unsupported - ValidationError", bad, domerr.ValidationError)
+	if bad.IsError() {
+		tf.AssertEqual("CreateNameCase unsupported - message", bad.ErrorInfo().Message,
+			`unsupported case "shouty" (supported: none, upper, lower, title)`)
+	}
+	tf.AssertEqual("String - title", valueobject.CaseTitle.String(), "title")
+
+	// ========================================================================
+	// Test: WithCase for each mode, including Unicode names
+	// ========================================================================
+
+	transforms := []struct {
+		name string
+		mode valueobject.NameCase
+		want string
+	}{
+		{"bob smith", valueobject.CaseNone, "bob smith"},
+		{"bob smith", valueobject.CaseUpper, "BOB SMITH"},
+		{"Bob SMITH", valueobject.CaseLower, "bob smith"},
+		{"bob smith", valueobject.CaseTitle, "Bob Smith"},
+		{"mary-jane o'connor", valueobject.CaseTitle, "Mary-Jane O'connor"},
+		{"josé garcía", valueobject.CaseUpper, "JOSÉ GARCÍA"},
+		{"ÉLODIE", valueobject.CaseLower, "élodie"},
+		{"élodie  dupont", valueobject.CaseTitle, "Élodie  Dupont"},
+		{"ǆuro", valueobject.CaseTitle, "ǅuro"},
+	}
+	for _, tc := range transforms {
+		label := "WithCase " + tc.mode.String() + " " + tc.name
+		person := valueobject.CreatePerson(tc.name).Value().WithCase(tc.mode).Value()
+		tf.AssertEqual(label+" - name", person.GetName(), tc.want)
+		tf.AssertEqual(label+" - greeting", person.GreetingMessage(), "Hello, "+tc.want+"!")
+	}
+
+	// ========================================================================
+	// Test: Immutability and title preservation
+	// ========================================================================
+
+	original := valueobject.NewPersonBuilder().WithTitle("dr.").WithName("alice").Build().Value()
+	shouted := original.WithCase(valueobject.CaseUpper).Value()
+	tf.AssertEqual("Immutable - original unchanged", original.GetName(), "alice")
+	tf.AssertEqual("Title - not transformed", shouted.GreetingMessage(), "Hello, dr. ALICE!")
+
+	// ========================================================================
+	// Test: A name that grows in bytes when cased keeps its length
+	// ========================================================================
+
+	// "ɐ" (U+0250) is 2 bytes; its upper case "Ɐ" (U+2C6F) is 3 bytes, but
+	// lengths count characters, so a full-length name stays valid
+	turned := valueobject.CreatePerson(strings.Repeat("ɐ", valueobject.MaxNameLength))
+	tf.RunTest("Growing name - valid before casing", turned.IsOk())
+	grown := turned.Value().WithCase(valueobject.CaseUpper)
+	tf.RunTest("Growing name - upper case still within MaxNameLength", grown.IsOk())
+	tf.AssertEqual("Growing name - transformed", grown.Value().GetName(),
+		strings.Repeat("Ɐ", valueobject.MaxNameLength))
+	short := valueobject.CreatePerson("ɐlice").Value().WithCase(valueobject.CaseUpper)
+	tf.AssertEqual("Growing name - within limit still Ok", short.Value().GetName(), "ⱯLICE")
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
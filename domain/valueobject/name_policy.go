@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: valueobject
+// Description: Name validation policy applied when creating a Person
+
+package valueobject
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+const (
+	// ErrMsgReservedName is the stable wording of the reserved-name error,
+	// a format (%q: the rejected name).
+	ErrMsgReservedName = "Person name %q is reserved"
+
+	// ErrMsgNameTooShort and ErrMsgNameNotASCII are the stable wording of
+	// the policy-specific length and character errors. ErrMsgNameTooShort
+	// is a format (%d: the minimum).
+	ErrMsgNameTooShort = "Person name must be at least %d characters"
+	ErrMsgNameNotASCII = "Person name must contain only ASCII characters"
+
+	// ErrMsgNamePolicyBounds is the stable wording of the error for a
+	// policy whose minimum length exceeds its maximum, a format (%d: the
+	// minimum, %d: the maximum).
+	ErrMsgNamePolicyBounds = "name policy minimum length %d exceeds maximum length %d"
+)
+
+// defaultReservedNames are rejected by CreatePerson and NewPersonBuilder.
+var defaultReservedNames = []string{"admin", "root", "system"}
+
+// NamePolicy holds the rules a Person name must satisfy: length bounds,
+// whether non-ASCII characters are allowed, and which names are reserved.
+//
+// Lengths are measured in characters (runes), as MaxNameLength is, so a
+// multi-byte name is not penalized for its encoding. Reserved-name
+// matching is case-insensitive and ignores surrounding whitespace, so
+// "Root", " ADMIN ", and "system" are all caught by the default policy.
+//
+// The zero value applies the default length bounds (1..MaxNameLength),
+// allows Unicode, and reserves nothing. Policies are values; the With
+// methods return modified copies.
+//
+// Usage:
+//
+//	policy := valueobject.DefaultNamePolicy().WithMaxLength(5)
+//	valueobject.CreatePersonWithPolicy("Alice", policy)  // Ok
+//	valueobject.CreatePersonWithPolicy("Alices", policy) // Err: too long
+type NamePolicy struct {
+	minLength int
+	maxLength int
+	asciiOnly bool
+	reserved  map[string]struct{}
+}
+
+// NewNamePolicy creates a policy with the default length bounds that
+// reserves exactly the given names.
+func NewNamePolicy(reserved []string) NamePolicy {
+	return NamePolicy{}.WithReserved(reserved)
+}
+
+// DefaultNamePolicy is the policy used by CreatePerson: 1..MaxNameLength
+// characters, Unicode allowed, and "admin", "root", and "system" reserved.
+func DefaultNamePolicy() NamePolicy {
+	return NewNamePolicy(defaultReservedNames)
+}
+
+// WithMinLength returns a copy requiring at least n characters. Names are
+// never allowed to be empty, so n < 1 means 1. A minimum above MaxLength
+// makes the policy invalid (see Validate).
+func (p NamePolicy) WithMinLength(n int) NamePolicy {
+	p.minLength = n
+	return p
+}
+
+// WithMaxLength returns a copy allowing at most n characters; n <= 0 restores
+// the default MaxNameLength.
+func (p NamePolicy) WithMaxLength(n int) NamePolicy {
+	p.maxLength = n
+	return p
+}
+
+// WithUnicode returns a copy that allows (true) or rejects (false) names
+// containing non-ASCII characters.
+func (p NamePolicy) WithUnicode(allow bool) NamePolicy {
+	p.asciiOnly = !allow
+	return p
+}
+
+// WithReserved returns a copy that reserves exactly the given names,
+// replacing any previous list.
+func (p NamePolicy) WithReserved(reserved []string) NamePolicy {
+	p.reserved = make(map[string]struct{}, len(reserved))
+	for _, name := range reserved {
+		p.reserved[normalizeName(name)] = struct{}{}
+	}
+	return p
+}
+
+// MinLength returns the minimum name length in characters (at least 1).
+func (p NamePolicy) MinLength() int {
+	return max(p.minLength, 1)
+}
+
+// MaxLength returns the maximum name length in characters.
+func (p NamePolicy) MaxLength() int {
+	if p.maxLength <= 0 {
+		return MaxNameLength
+	}
+	return p.maxLength
+}
+
+// AllowsUnicode reports whether names may contain non-ASCII characters.
+func (p NamePolicy) AllowsUnicode() bool {
+	return !p.asciiOnly
+}
+
+// IsReserved reports whether name is reserved under this policy.
+func (p NamePolicy) IsReserved(name string) bool {
+	_, found := p.reserved[normalizeName(name)]
+	return found
+}
+
+// Validate checks that the policy can accept some name: its minimum
+// length must not exceed its maximum.
+//
+// Contract:
+//   - Post: Returns Ok(p) if MinLength() <= MaxLength()
+//   - Post: Otherwise returns Err(ValidationError) with
+//     ErrMsgNamePolicyBounds
+func (p NamePolicy) Validate() domerr.Result[NamePolicy] {
+	if p.MinLength() > p.MaxLength() {
+		return domerr.Err[NamePolicy](domerr.NewValidationError(
+			fmt.Sprintf(ErrMsgNamePolicyBounds, p.MinLength(), p.MaxLength())))
+	}
+	return domerr.Ok(p)
+}
+
+// validate applies the policy's rules to name, in order: a valid policy
+// (Validate), non-empty, minimum length, maximum length, ASCII-only (if
+// set), not reserved. Lengths count runes.
+//
+// Contract:
+//   - Post: Returns Err(ValidationError) for the first rule violated
+//   - Post: If valid, returns Ok with name unchanged
+func (p NamePolicy) validate(name string) domerr.Result[string] {
+	if policy := p.Validate(); policy.IsError() {
+		return domerr.Err[string](policy.ErrorInfo())
+	}
+	if name == "" {
+		return domerr.Err[string](domerr.NewValidationError(ErrMsgEmptyName))
+	}
+	length := utf8.RuneCountInString(name)
+	if length < p.MinLength() {
+		return domerr.Err[string](domerr.NewValidationError(
+			fmt.Sprintf(ErrMsgNameTooShort, p.MinLength())))
+	}
+	if length > p.MaxLength() {
+		return domerr.Err[string](domerr.NewValidationError(
+			fmt.Sprintf(ErrMsgNameTooLong, p.MaxLength())))
+	}
+	if p.asciiOnly && !isASCII(name) {
+		return domerr.Err[string](domerr.NewValidationError(ErrMsgNameNotASCII))
+	}
+	return domerr.Ok(name).Filter(
+		func(n string) bool { return !p.IsReserved(n) },
+		domerr.NewValidationError(fmt.Sprintf(ErrMsgReservedName, name)),
+	)
+}
+
+// isASCII reports whether s contains only ASCII characters.
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeName is the comparison key for reserved names.
+func normalizeName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// CreatePersonWithPolicy is CreatePerson with a caller-supplied name
+// policy instead of DefaultNamePolicy.
+//
+// Usage:
+//
+//	policy := valueobject.NewNamePolicy([]string{"guest"})
+//	result := valueobject.CreatePersonWithPolicy("Guest", policy) // Err: reserved
+func CreatePersonWithPolicy(name string, policy NamePolicy) domerr.Result[Person] {
+	return NewPersonBuilder().WithName(name).WithPolicy(policy).Build()
+}
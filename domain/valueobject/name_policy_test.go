@@ -0,0 +1,146 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package valueobject_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/domain/valueobject"
+)
+
+// TestDomainValueObjectNamePolicy tests reserved-name rejection.
+func TestDomainValueObjectNamePolicy(t *testing.T) {
+	tf := test.New("Domain.ValueObject.NamePolicy")
+
+	// ========================================================================
+	// Test: Default blocklist rejects reserved names
+	// ========================================================================
+
+	root := valueobject.CreatePerson("root")
+	tf.AssertError("Reserved root - ValidationError", root, domerr.ValidationError)
+	if root.IsError() {
+		tf.AssertEqual("Reserved root - message",
+			root.ErrorInfo().Message, fmt.Sprintf(valueobject.ErrMsgReservedName, "root"))
+	}
+
+	// ========================================================================
+	// Test: Case and whitespace variants are rejected
+	// ========================================================================
+
+	for _, name := range []string{"Admin", "SYSTEM", "  Root  "} {
+		tf.AssertError("Variant "+name+" - ValidationError",
+			valueobject.CreatePerson(name), domerr.ValidationError)
+	}
+
+	// ========================================================================
+	// Test: Normal names are accepted
+	// ========================================================================
+
+	for _, name := range []string{"Alice", "Rooth", "Administrator Bob"} {
+		tf.RunTest("Normal "+name+" - IsOk", valueobject.CreatePerson(name).IsOk())
+	}
+
+	// ========================================================================
+	// Test: Custom policy via CreatePersonWithPolicy
+	// ========================================================================
+
+	policy := valueobject.NewNamePolicy([]string{"Guest"})
+	tf.AssertError("Custom - guest rejected",
+		valueobject.CreatePersonWithPolicy("guest", policy), domerr.ValidationError)
+	tf.RunTest("Custom - root allowed when not listed",
+		valueobject.CreatePersonWithPolicy("root", policy).IsOk())
+	tf.RunTest("Zero policy - reserves nothing",
+		valueobject.CreatePersonWithPolicy("admin", valueobject.NamePolicy{}).IsOk())
+	tf.RunTest("Custom - other validation still applies",
+		valueobject.CreatePersonWithPolicy("", policy).IsError())
+
+	// ========================================================================
+	// Test: Custom length bounds
+	// ========================================================================
+
+	short := valueobject.DefaultNamePolicy().WithMaxLength(5)
+	tf.RunTest("Max 5 - Alice accepted", valueobject.CreatePersonWithPolicy("Alice", short).IsOk())
+	alices := valueobject.CreatePersonWithPolicy("Alices", short)
+	tf.AssertError("Max 5 - Alices rejected", alices, domerr.ValidationError)
+	if alices.IsError() {
+		tf.AssertEqual("Max 5 - message",
+			alices.ErrorInfo().Message, fmt.Sprintf(valueobject.ErrMsgNameTooLong, 5))
+	}
+	tf.AssertError("Max 5 - reserved names still rejected",
+		valueobject.CreatePersonWithPolicy("root", short), domerr.ValidationError)
+
+	atLeast3 := valueobject.DefaultNamePolicy().WithMinLength(3)
+	tf.RunTest("Min 3 - Bob accepted", valueobject.CreatePersonWithPolicy("Bob", atLeast3).IsOk())
+	al := valueobject.CreatePersonWithPolicy("Al", atLeast3)
+	tf.AssertError("Min 3 - Al rejected", al, domerr.ValidationError)
+	if al.IsError() {
+		tf.AssertEqual("Min 3 - message",
+			al.ErrorInfo().Message, fmt.Sprintf(valueobject.ErrMsgNameTooShort, 3))
+	}
+	// ========================================================================
+	// Test: Lengths count characters, not bytes
+	// ========================================================================
+
+	// "José" is 4 characters but 5 bytes; "北京" is 2 characters, 6 bytes
+	tf.RunTest("Max 4 - José accepted",
+		valueobject.CreatePersonWithPolicy("José", valueobject.DefaultNamePolicy().WithMaxLength(4)).IsOk())
+	tf.RunTest("Min 3 - 北京 rejected",
+		valueobject.CreatePersonWithPolicy("北京", atLeast3).IsError())
+	tf.RunTest("Default - MaxNameLength multi-byte characters accepted",
+		valueobject.CreatePerson(strings.Repeat("é", valueobject.MaxNameLength)).IsOk())
+	tf.RunTest("Default - MaxNameLength+1 multi-byte characters rejected",
+		valueobject.CreatePerson(strings.Repeat("é", valueobject.MaxNameLength+1)).IsError())
+
+	// ========================================================================
+	// Test: A minimum above the maximum is rejected
+	// ========================================================================
+
+	inverted := valueobject.DefaultNamePolicy().WithMaxLength(5).WithMinLength(6)
+	tf.AssertError("Min > Max - Validate fails", inverted.Validate(), domerr.ValidationError)
+	if bad := inverted.Validate(); bad.IsError() {
+		tf.AssertEqual("Min > Max - message", bad.ErrorInfo().Message,
+			fmt.Sprintf(valueobject.ErrMsgNamePolicyBounds, 6, 5))
+	}
+	tf.AssertError("Min > Max - every name rejected",
+		valueobject.CreatePersonWithPolicy("Alice", inverted), domerr.ValidationError)
+	tf.RunTest("Min == Max - Validate Ok",
+		valueobject.DefaultNamePolicy().WithMinLength(5).WithMaxLength(5).Validate().IsOk())
+	tf.RunTest("Default - Validate Ok", valueobject.DefaultNamePolicy().Validate().IsOk())
+
+	tf.AssertEqual("Min 0 - empty still rejected",
+		valueobject.CreatePersonWithPolicy("", valueobject.NamePolicy{}.WithMinLength(0)).ErrorInfo().Message,
+		valueobject.ErrMsgEmptyName)
+
+	// ========================================================================
+	// Test: Unicode handling
+	// ========================================================================
+
+	ascii := valueobject.DefaultNamePolicy().WithUnicode(false)
+	tf.RunTest("ASCII only - José rejected",
+		valueobject.CreatePersonWithPolicy("José", ascii).IsError())
+	tf.RunTest("ASCII only - Jose accepted",
+		valueobject.CreatePersonWithPolicy("Jose", ascii).IsOk())
+	tf.RunTest("Unicode allowed - José accepted",
+		valueobject.CreatePersonWithPolicy("José", ascii.WithUnicode(true)).IsOk())
+
+	// ========================================================================
+	// Test: Default policy is unchanged
+	// ========================================================================
+
+	def := valueobject.DefaultNamePolicy()
+	tf.AssertEqual("Default - MinLength", def.MinLength(), 1)
+	tf.AssertEqual("Default - MaxLength", def.MaxLength(), valueobject.MaxNameLength)
+	tf.RunTest("Default - AllowsUnicode", def.AllowsUnicode())
+	maxName := strings.Repeat("a", valueobject.MaxNameLength)
+	tf.RunTest("Default - max length name accepted", valueobject.CreatePerson(maxName).IsOk())
+	tf.RunTest("Default - max length + 1 rejected", valueobject.CreatePerson(maxName+"a").IsError())
+	tf.RunTest("Default - unicode accepted", valueobject.CreatePerson("北京").IsOk())
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
@@ -99,6 +99,18 @@ func (o Option[T]) Value() T {
 // Unwrap operations (extract value or use default)
 // ============================================================================
 
+// Unwrap returns the value and true if Some, or the zero value and false if
+// None. It is the non-panicking counterpart of Value.
+//
+// Example:
+//
+//	if title, ok := opt.Unwrap(); ok {
+//	    // use title
+//	}
+func (o Option[T]) Unwrap() (T, bool) {
+	return o.value, o.isSome
+}
+
 // UnwrapOr returns the value if Some, otherwise returns the default value.
 //
 // Example:
@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package valueobject_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/domain/valueobject"
+)
+
+// TestDomainValueObjectOption tests Option construction, unwrapping, and Map.
+func TestDomainValueObjectOption(t *testing.T) {
+	tf := test.New("Domain.ValueObject.Option")
+
+	some := valueobject.Some("Dr.")
+	none := valueobject.None[string]()
+
+	// ========================================================================
+	// Test: Construction
+	// ========================================================================
+
+	tf.RunTest("Some - IsSome", some.IsSome())
+	tf.RunTest("Some - not IsNone", !some.IsNone())
+	tf.RunTest("None - IsNone", none.IsNone())
+	tf.RunTest("None - not IsSome", !none.IsSome())
+	tf.RunTest("Zero value - is None", valueobject.Option[string]{}.IsNone())
+	tf.RunTest("Some of zero value - still Some", valueobject.Some("").IsSome())
+
+	// ========================================================================
+	// Test: Safe unwrapping
+	// ========================================================================
+
+	value, ok := some.Unwrap()
+	tf.RunTest("Unwrap Some - ok", ok)
+	tf.AssertEqual("Unwrap Some - value", value, "Dr.")
+
+	value, ok = none.Unwrap()
+	tf.RunTest("Unwrap None - not ok", !ok)
+	tf.AssertEqual("Unwrap None - zero value", value, "")
+
+	tf.AssertEqual("UnwrapOr Some - value", some.UnwrapOr("Mx."), "Dr.")
+	tf.AssertEqual("UnwrapOr None - default", none.UnwrapOr("Mx."), "Mx.")
+	tf.AssertEqual("UnwrapOrElse None - computed", none.UnwrapOrElse(func() string { return "Mx." }), "Mx.")
+
+	panicked := func() (p bool) {
+		defer func() { p = recover() != nil }()
+		none.Value()
+		return false
+	}()
+	tf.RunTest("Value on None - panics", panicked)
+
+	// ========================================================================
+	// Test: Map over both states
+	// ========================================================================
+
+	calls := 0
+	shout := func(s string) string { calls++; return s + "!" }
+	tf.AssertEqual("Map Some - transformed", some.Map(shout).UnwrapOr(""), "Dr.!")
+	tf.RunTest("Map None - stays None", none.Map(shout).IsNone())
+	tf.AssertEqual("Map None - function not called", calls, 1)
+
+	length := valueobject.MapTo(some, func(s string) int { return len(s) })
+	tf.AssertEqual("MapTo Some - new type", length.UnwrapOr(0), 3)
+	tf.RunTest("MapTo None - stays None",
+		valueobject.MapTo(valueobject.None[int](), strconv.Itoa).IsNone())
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
@@ -12,7 +12,7 @@
 //   - Smart constructors enforce validation
 //   - Returns Result[T] for validation (no panics)
 //   - Pure domain logic - ZERO external module dependencies
-//   - Domain provides data (GetName), Application formats output
+//   - Domain provides data (GetName) and the canonical greeting (GreetingMessage)
 //
 // Usage:
 //
@@ -21,20 +21,42 @@
 //	result := valueobject.CreatePerson("Alice")
 //	if result.IsOk() {
 //	    person := result.Value()
-//	    name := person.GetName()  // Use in application layer
+//	    name := person.GetName()            // "Alice"
+//	    greeting := person.GreetingMessage() // "Hello, Alice!"
 //	}
 package valueobject
 
 import (
 	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
 )
 
 const (
-	// MaxNameLength is the maximum allowed length for a person's name.
-	// This is a reasonable limit for person names in most applications.
+	// MaxNameLength is the maximum allowed length for a person's name, in
+	// characters (runes). This is a reasonable limit for person names in
+	// most applications.
 	MaxNameLength = 100
+
+	// ErrMsgEmptyName and ErrMsgNameTooLong are the stable wording of name
+	// validation errors; callers may match on them, so changing the text
+	// is a breaking change. ErrMsgNameTooLong is a format (%d: the limit).
+	ErrMsgEmptyName   = "Person name cannot be empty"
+	ErrMsgNameTooLong = "Person name exceeds maximum length of %d characters"
+
+	// greetingPrefix and greetingSuffix frame the name in GreetingMessage.
+	greetingPrefix = "Hello, "
+	greetingSuffix = "!"
+
+	// DefaultPunctuation ends GreetingMessage.
+	DefaultPunctuation = greetingSuffix
+
+	// MaxPunctuationLength is the most characters (runes) accepted by
+	// GreetingMessageWithPunctuation, enough for "!", ".", or "?!".
+	MaxPunctuationLength = 2
 )
 
 // Person represents a person's name as an immutable value object.
@@ -48,43 +70,61 @@ const (
 // Contract:
 //   - Name is never empty (enforced by Create)
 //   - Name never exceeds MaxNameLength (enforced by Create)
+//   - Title is optional (None unless set via PersonBuilder.WithTitle)
+//   - Greeting is computed once at creation and always matches Title and Name
 //   - Use Create() to instantiate, not struct literal
 type Person struct {
-	name string
+	name     string
+	title    Option[string]
+	greeting string
 }
 
 // CreatePerson creates a new Person value object with validation.
 //
-// This is the RECOMMENDED way to create a Person. Direct struct instantiation
-// bypasses validation and should be avoided.
+// This is the RECOMMENDED way to create a Person from a bare name. It is a
+// shortcut for NewPersonBuilder().WithName(name).Build(); use the builder
+// directly when optional fields such as a title are needed. Direct struct
+// instantiation bypasses validation and should be avoided.
 //
 // Validation rules:
 //  1. Name must not be empty
 //  2. Name must not exceed MaxNameLength
-//  3. Name may contain spaces and Unicode characters
-//  4. Whitespace is preserved exactly as provided
+//  3. Name must not be reserved (DefaultNamePolicy: admin, root, system;
+//     case-insensitive, surrounding whitespace ignored)
+//  4. Name may contain spaces and Unicode characters
+//  5. Whitespace is preserved exactly as provided
+//
+// Use CreatePersonWithPolicy to supply different length bounds, Unicode
+// handling, or reserved names.
 //
 // Returns:
 //   - domerr.Result[Person] - Ok if valid, Err if validation fails
 //
 // Contract (expressed in comments since Go lacks Pre/Post):
 //   - Pre: name parameter can be any string
-//   - Post: If name is empty or exceeds MaxNameLength, returns Err
+//   - Post: If name is empty, exceeds MaxNameLength, or is reserved, returns Err
 //   - Post: If valid, returns Ok with Person where GetName() returns exact input
 func CreatePerson(name string) domerr.Result[Person] {
-	// Validation 1: Check for empty string
-	if len(name) == 0 {
-		return domerr.Err[Person](domerr.NewValidationError("Person name cannot be empty"))
-	}
+	return NewPersonBuilder().WithName(name).Build()
+}
 
-	// Validation 2: Check maximum length
-	if len(name) > MaxNameLength {
-		return domerr.Err[Person](domerr.NewValidationError(
-			fmt.Sprintf("Person name exceeds maximum length of %d characters", MaxNameLength)))
-	}
+// buildGreeting formats "Hello, [<title> ]<name>!" with a single allocation.
+func buildGreeting(name string, title Option[string]) string {
+	return formatGreeting(greetingPrefix, greetingSuffix, name, title)
+}
 
-	// All validations passed - create the value object
-	return domerr.Ok(Person{name: name})
+// formatGreeting formats "<prefix>[<title> ]<name><suffix>" with a single allocation.
+func formatGreeting(prefix, suffix, name string, title Option[string]) string {
+	var b strings.Builder
+	b.Grow(len(prefix) + len(title.UnwrapOr("")) + 1 + len(name) + len(suffix))
+	b.WriteString(prefix)
+	if title.IsSome() {
+		b.WriteString(title.Value())
+		b.WriteByte(' ')
+	}
+	b.WriteString(name)
+	b.WriteString(suffix)
+	return b.String()
 }
 
 // GetName returns the string representation of the person's name.
@@ -96,6 +136,75 @@ func (p Person) GetName() string {
 	return p.name
 }
 
+// GetTitle returns the person's optional title (e.g., "Dr.").
+//
+// Contract:
+//   - Post: If Some, the title is non-empty and <= MaxTitleLength
+func (p Person) GetTitle() Option[string] {
+	return p.title
+}
+
+// GreetingMessage returns the greeting for this person ("Hello, <name>!",
+// or "Hello, <title> <name>!" when a title is present).
+//
+// The message is computed once at creation and stored on the value
+// object, so repeated calls return the same string without allocating.
+//
+// Contract:
+//   - Post: Result is "Hello, " + [title + " "] + GetName() + "!"
+func (p Person) GreetingMessage() string {
+	return p.greeting
+}
+
+// GreetingMessageFor returns the greeting for this person in the given locale.
+//
+// Fallback Policy: There is NO silent fallback to English. A Locale that is
+// not in the built-in table (e.g., the zero value) returns a ValidationError,
+// so callers cannot mistake an unsupported language for a supported one.
+// Use CreateLocale to obtain a Locale that is guaranteed to be supported.
+//
+// Contract:
+//   - Post: GreetingMessageFor(LocaleEnglish) equals GreetingMessage()
+//   - Post: Returns Err(ValidationError) if locale is not supported
+func (p Person) GreetingMessageFor(locale Locale) domerr.Result[string] {
+	tmpl, ok := greetingTemplates[locale.code]
+	if !ok {
+		return domerr.Err[string](domerr.NewValidationError(
+			fmt.Sprintf("unsupported locale %q", locale.code)))
+	}
+	if locale == LocaleEnglish {
+		return domerr.Ok(p.greeting)
+	}
+	return domerr.Ok(formatGreeting(tmpl.prefix, tmpl.suffix, p.name, p.title))
+}
+
+// GreetingMessageWithPunctuation returns the greeting for this person
+// ending in punctuation instead of DefaultPunctuation ("Hello, Alice." for
+// "."); empty punctuation ends the greeting at the name.
+//
+// Contract:
+//   - Post: GreetingMessageWithPunctuation(DefaultPunctuation) equals
+//     GreetingMessage()
+//   - Post: Returns Err(ValidationError) if punctuation is longer than
+//     MaxPunctuationLength characters, is not valid UTF-8, or contains a
+//     space or non-printable character
+func (p Person) GreetingMessageWithPunctuation(punctuation string) domerr.Result[string] {
+	if punctuation == DefaultPunctuation {
+		return domerr.Ok(p.greeting)
+	}
+	if !utf8.ValidString(punctuation) || utf8.RuneCountInString(punctuation) > MaxPunctuationLength {
+		return domerr.Err[string](domerr.NewValidationError(fmt.Sprintf(
+			"punctuation %q must be at most %d characters", punctuation, MaxPunctuationLength)))
+	}
+	for _, r := range punctuation {
+		if r == ' ' || !unicode.IsPrint(r) {
+			return domerr.Err[string](domerr.NewValidationError(fmt.Sprintf(
+				"punctuation %q must contain only printable, non-space characters", punctuation)))
+		}
+	}
+	return domerr.Ok(formatGreeting(greetingPrefix, punctuation, p.name, p.title))
+}
+
 // IsValid checks if the person satisfies the type invariant.
 //
 // Type Invariant: A Person is valid if and only if its name is non-empty.
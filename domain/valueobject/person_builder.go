@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: valueobject
+// Description: Fluent builder for Person value objects with optional fields
+
+package valueobject
+
+import (
+	"fmt"
+
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+const (
+	// MaxTitleLength is the maximum allowed length for a person's title.
+	MaxTitleLength = 20
+
+	// ErrMsgEmptyTitle and ErrMsgTitleTooLong are the stable wording of
+	// title validation errors. ErrMsgTitleTooLong is a format (%d: the limit).
+	ErrMsgEmptyTitle   = "Person title cannot be empty"
+	ErrMsgTitleTooLong = "Person title exceeds maximum length of %d characters"
+)
+
+// PersonBuilder assembles a Person from required and optional fields.
+//
+// Design Pattern: Builder
+//   - Fluent With* methods collect raw input without validating
+//   - Build() runs ALL validations and returns a Result
+//   - Value receivers: each With* returns a modified copy, so a partially
+//     configured builder can be safely reused as a template
+//
+// Usage:
+//
+//	result := valueobject.NewPersonBuilder().
+//	    WithName("Alice").
+//	    WithTitle("Dr.").
+//	    Build()
+//	// result.Value().GreetingMessage() == "Hello, Dr. Alice!"
+type PersonBuilder struct {
+	name   string
+	title  Option[string]
+	policy NamePolicy
+}
+
+// NewPersonBuilder creates an empty PersonBuilder (no name, no title) that
+// applies DefaultNamePolicy.
+func NewPersonBuilder() PersonBuilder {
+	return PersonBuilder{title: None[string](), policy: DefaultNamePolicy()}
+}
+
+// WithName sets the person's name. Validation is deferred to Build.
+func (b PersonBuilder) WithName(name string) PersonBuilder {
+	b.name = name
+	return b
+}
+
+// WithTitle sets the person's optional title (e.g., "Dr.", "Prof.").
+// Validation is deferred to Build.
+func (b PersonBuilder) WithTitle(title string) PersonBuilder {
+	b.title = Some(title)
+	return b
+}
+
+// WithPolicy replaces the name policy applied by Build.
+func (b PersonBuilder) WithPolicy(policy NamePolicy) PersonBuilder {
+	b.policy = policy
+	return b
+}
+
+// Build validates all fields and creates the Person.
+//
+// Validation rules:
+//  1. Name satisfies the builder's NamePolicy (by default the same rules
+//     as CreatePerson: non-empty, <= MaxNameLength, not reserved)
+//  2. Title, if set, must not be empty
+//  3. Title, if set, must not exceed MaxTitleLength
+//
+// Contract:
+//   - Post: Returns Err(ValidationError) for the first rule violated
+//   - Post: If valid, GetName() and GetTitle() return the exact inputs
+func (b PersonBuilder) Build() domerr.Result[Person] {
+	return domerr.AndThenTo(b.policy.validate(b.name), func(name string) domerr.Result[Person] {
+		return domerr.MapTo(validateTitle(b.title), func(title Option[string]) Person {
+			return Person{name: name, title: title, greeting: buildGreeting(name, title)}
+		})
+	})
+}
+
+// validateTitle applies the title rules when a title is present.
+func validateTitle(title Option[string]) domerr.Result[Option[string]] {
+	if title.IsNone() {
+		return domerr.Ok(title)
+	}
+
+	value := title.Value()
+	if len(value) == 0 {
+		return domerr.Err[Option[string]](domerr.NewValidationError(ErrMsgEmptyTitle))
+	}
+	if len(value) > MaxTitleLength {
+		return domerr.Err[Option[string]](domerr.NewValidationError(
+			fmt.Sprintf(ErrMsgTitleTooLong, MaxTitleLength)))
+	}
+
+	return domerr.Ok(title)
+}
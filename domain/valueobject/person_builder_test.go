@@ -0,0 +1,106 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package valueobject_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/domain/valueobject"
+)
+
+// TestDomainValueObjectPersonBuilder tests the PersonBuilder fluent API.
+func TestDomainValueObjectPersonBuilder(t *testing.T) {
+	tf := test.New("Domain.ValueObject.PersonBuilder")
+
+	// ========================================================================
+	// Test: Name only
+	// ========================================================================
+
+	r1 := valueobject.NewPersonBuilder().WithName("Alice").Build()
+	tf.RunTest("Name only - IsOk returns true", r1.IsOk())
+	if r1.IsOk() {
+		person := r1.Value()
+		tf.RunTest("Name only - GetName correct", person.GetName() == "Alice")
+		tf.RunTest("Name only - GetTitle is None", person.GetTitle().IsNone())
+		tf.RunTest("Name only - greeting has no title",
+			person.GreetingMessage() == "Hello, Alice!")
+	}
+
+	// ========================================================================
+	// Test: Name and title
+	// ========================================================================
+
+	r2 := valueobject.NewPersonBuilder().WithName("Alice").WithTitle("Dr.").Build()
+	tf.RunTest("Name and title - IsOk returns true", r2.IsOk())
+	if r2.IsOk() {
+		person := r2.Value()
+		tf.RunTest("Name and title - GetName excludes title", person.GetName() == "Alice")
+		tf.RunTest("Name and title - GetTitle is Some(\"Dr.\")",
+			person.GetTitle().IsSome() && person.GetTitle().Value() == "Dr.")
+		tf.RunTest("Name and title - greeting includes title",
+			person.GreetingMessage() == "Hello, Dr. Alice!")
+	}
+
+	// ========================================================================
+	// Test: CreatePerson is equivalent to builder with name only
+	// ========================================================================
+
+	r3 := valueobject.CreatePerson("Bob")
+	r4 := valueobject.NewPersonBuilder().WithName("Bob").Build()
+	tf.RunTest("CreatePerson shortcut - matches builder",
+		r3.IsOk() && r4.IsOk() && r3.Value() == r4.Value())
+
+	// ========================================================================
+	// Test: Invalid combinations
+	// ========================================================================
+
+	invalid := []struct {
+		name    string
+		builder valueobject.PersonBuilder
+		message string
+	}{
+		{"title without name",
+			valueobject.NewPersonBuilder().WithTitle("Dr."), valueobject.ErrMsgEmptyName},
+		{"empty title",
+			valueobject.NewPersonBuilder().WithName("Alice").WithTitle(""), valueobject.ErrMsgEmptyTitle},
+		{"title too long",
+			valueobject.NewPersonBuilder().WithName("Alice").
+				WithTitle(strings.Repeat("t", valueobject.MaxTitleLength+1)),
+			fmt.Sprintf(valueobject.ErrMsgTitleTooLong, valueobject.MaxTitleLength)},
+		{"name too long with valid title",
+			valueobject.NewPersonBuilder().WithTitle("Dr.").
+				WithName(strings.Repeat("a", valueobject.MaxNameLength+1)),
+			fmt.Sprintf(valueobject.ErrMsgNameTooLong, valueobject.MaxNameLength)},
+	}
+	for _, tc := range invalid {
+		r := tc.builder.Build()
+		tf.RunTest("Invalid "+tc.name+" - IsError returns true", r.IsError())
+		if r.IsError() {
+			info := r.ErrorInfo()
+			tf.RunTest("Invalid "+tc.name+" - kind is ValidationError",
+				info.Kind == domerr.ValidationError)
+			tf.AssertEqual("Invalid "+tc.name+" - message", info.Message, tc.message)
+		}
+	}
+
+	// ========================================================================
+	// Test: Builder copies are independent (value semantics)
+	// ========================================================================
+
+	base := valueobject.NewPersonBuilder().WithName("Carol")
+	withTitle := base.WithTitle("Prof.")
+	rBase := base.Build()
+	rTitled := withTitle.Build()
+	tf.RunTest("Builder reuse - base has no title",
+		rBase.IsOk() && rBase.Value().GetTitle().IsNone())
+	tf.RunTest("Builder reuse - derived has title",
+		rTitled.IsOk() && rTitled.Value().GreetingMessage() == "Hello, Prof. Carol!")
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package valueobject_test
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/valueobject"
+)
+
+// FuzzCreatePerson checks CreatePerson's invariants on arbitrary input.
+//
+// Run with: go test -run '^$' -fuzz FuzzCreatePerson ./valueobject/
+func FuzzCreatePerson(f *testing.F) {
+	seeds := []string{
+		"Alice", "", " ", "José García", "O'Brien, Jr.", "admin", " Root ",
+		"a\x00b", "\xed\xa0\x80", "\xff\xfe", "\U0001F44B\U0001F3FD", "\u200b",
+		strings.Repeat("x", valueobject.MaxNameLength),
+		strings.Repeat("x", valueobject.MaxNameLength+1),
+		strings.Repeat("é", valueobject.MaxNameLength),
+		strings.Repeat("é", valueobject.MaxNameLength+1),
+	}
+	for _, seed := range seeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, name string) {
+		result := valueobject.CreatePerson(name)
+
+		if result.IsError() {
+			if kind := result.ErrorInfo().Kind; kind != domerr.ValidationError {
+				t.Fatalf("CreatePerson(%q) error kind = %v, want ValidationError", name, kind)
+			}
+			return
+		}
+
+		person := result.Value()
+		got := person.GetName()
+		if got == "" {
+			t.Fatalf("CreatePerson(%q) returned Ok with an empty name", name)
+		}
+		if n := utf8.RuneCountInString(got); n > valueobject.MaxNameLength {
+			t.Fatalf("CreatePerson(%q) name length %d exceeds %d", name, n, valueobject.MaxNameLength)
+		}
+		greeting := person.GreetingMessage()
+		if !strings.HasPrefix(greeting, "Hello, ") || !strings.HasSuffix(greeting, "!") {
+			t.Fatalf("CreatePerson(%q) greeting %q is not \"Hello, ...!\"", name, greeting)
+		}
+		if !person.IsValid() {
+			t.Fatalf("CreatePerson(%q) returned Ok but IsValid() is false", name)
+		}
+	})
+}
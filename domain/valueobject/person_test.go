@@ -6,6 +6,8 @@
 package valueobject_test
 
 import (
+	"fmt"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -43,8 +45,8 @@ func TestDomainValueObjectPerson(t *testing.T) {
 		info := r2.ErrorInfo()
 		tf.RunTest("CreatePerson empty - error kind is ValidationError",
 			info.Kind == domerr.ValidationError)
-		tf.RunTest("CreatePerson empty - error message mentions 'empty'",
-			strings.Contains(info.Message, "empty"))
+		tf.AssertEqual("CreatePerson empty - error message",
+			info.Message, valueobject.ErrMsgEmptyName)
 	}
 
 	// ========================================================================
@@ -58,8 +60,8 @@ func TestDomainValueObjectPerson(t *testing.T) {
 		info := r3.ErrorInfo()
 		tf.RunTest("CreatePerson too long - error kind is ValidationError",
 			info.Kind == domerr.ValidationError)
-		tf.RunTest("CreatePerson too long - error message mentions 'exceeds'",
-			strings.Contains(info.Message, "exceeds"))
+		tf.AssertEqual("CreatePerson too long - error message",
+			info.Message, fmt.Sprintf(valueobject.ErrMsgNameTooLong, valueobject.MaxNameLength))
 	}
 
 	// ========================================================================
@@ -116,3 +118,143 @@ func TestDomainValueObjectPerson(t *testing.T) {
 	// Print summary and fail test if any failed
 	tf.Summary(t)
 }
+
+// TestDomainValueObjectPersonGreeting tests the memoized greeting message.
+func TestDomainValueObjectPersonGreeting(t *testing.T) {
+	tf := test.New("Domain.ValueObject.Person.GreetingMessage")
+
+	// ========================================================================
+	// Test: GreetingMessage format
+	// ========================================================================
+
+	r1 := valueobject.CreatePerson("Alice")
+	if r1.IsOk() {
+		tf.RunTest("GreetingMessage - format is 'Hello, <name>!'",
+			r1.Value().GreetingMessage() == "Hello, Alice!")
+	} else {
+		tf.RunTest("GreetingMessage - Result should be Ok", false)
+	}
+
+	// ========================================================================
+	// Test: Memoized greeting stays in sync with GetName
+	// ========================================================================
+
+	names := []string{"X", "Bob Smith", "José García", "   ",
+		strings.Repeat("a", valueobject.MaxNameLength)}
+	for _, name := range names {
+		r := valueobject.CreatePerson(name)
+		if r.IsError() {
+			tf.RunTest("GreetingMessage sync - Result should be Ok for "+name, false)
+			continue
+		}
+		person := r.Value()
+		tf.RunTest("GreetingMessage sync - matches GetName for "+name,
+			person.GreetingMessage() == "Hello, "+person.GetName()+"!")
+	}
+
+	// ========================================================================
+	// Test: Repeated calls return the identical string
+	// ========================================================================
+
+	if r1.IsOk() {
+		person := r1.Value()
+		tf.RunTest("GreetingMessage - repeated calls are identical",
+			person.GreetingMessage() == person.GreetingMessage())
+	}
+
+	// ========================================================================
+	// Test: GreetingMessageWithPunctuation
+	// ========================================================================
+
+	alice := valueobject.CreatePerson("Alice").Value()
+	tf.AssertEqual("Punctuation '!' - default greeting",
+		alice.GreetingMessageWithPunctuation("!"), domerr.Ok(alice.GreetingMessage()))
+	tf.AssertEqual("Punctuation '.' - period",
+		alice.GreetingMessageWithPunctuation("."), domerr.Ok("Hello, Alice."))
+	tf.AssertEqual("Punctuation empty - none",
+		alice.GreetingMessageWithPunctuation(""), domerr.Ok("Hello, Alice"))
+	tf.AssertEqual("Punctuation '?!' - two characters",
+		alice.GreetingMessageWithPunctuation("?!"), domerr.Ok("Hello, Alice?!"))
+	dr := valueobject.NewPersonBuilder().WithName("Alice").WithTitle("Dr.").Build().Value()
+	tf.AssertEqual("Punctuation with title",
+		dr.GreetingMessageWithPunctuation("."), domerr.Ok("Hello, Dr. Alice."))
+
+	for _, bad := range []string{"!!!", " !", "\t", "\x00", "\xff"} {
+		tf.AssertError("Punctuation invalid - "+strconv.Quote(bad),
+			alice.GreetingMessageWithPunctuation(bad), domerr.ValidationError)
+	}
+	tf.AssertEqual("Punctuation too long - message",
+		alice.GreetingMessageWithPunctuation("!!!").ErrorInfo().Message,
+		`punctuation "!!!" must be at most 2 characters`)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
+
+// BenchmarkPersonGreetingMessage measures the cost of obtaining a greeting
+// from an already-created Person (memoized, zero allocations expected).
+func BenchmarkPersonGreetingMessage(b *testing.B) {
+	person := valueobject.CreatePerson("Alice").Value()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = person.GreetingMessage()
+	}
+}
+
+// BenchmarkCreatePerson measures validation plus greeting construction.
+func BenchmarkCreatePerson(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = valueobject.CreatePerson("Alice")
+	}
+}
+
+// TestDomainValueObjectPersonErrorMessages pins the exported error wording
+// to the errors actually produced.
+func TestDomainValueObjectPersonErrorMessages(t *testing.T) {
+	tf := test.New("Domain.ValueObject.Person.ErrorMessages")
+
+	// ========================================================================
+	// Test: Constants are non-empty
+	// ========================================================================
+
+	for name, msg := range map[string]string{
+		"ErrMsgEmptyName":    valueobject.ErrMsgEmptyName,
+		"ErrMsgNameTooLong":  valueobject.ErrMsgNameTooLong,
+		"ErrMsgEmptyTitle":   valueobject.ErrMsgEmptyTitle,
+		"ErrMsgTitleTooLong": valueobject.ErrMsgTitleTooLong,
+		"ErrMsgReservedName": valueobject.ErrMsgReservedName,
+	} {
+		tf.RunTest(name+" - non-empty", msg != "")
+	}
+
+	// ========================================================================
+	// Test: Produced errors use the constants
+	// ========================================================================
+
+	cases := []struct {
+		name   string
+		result domerr.Result[valueobject.Person]
+		want   string
+	}{
+		{"empty name", valueobject.CreatePerson(""), valueobject.ErrMsgEmptyName},
+		{"name too long", valueobject.CreatePerson(strings.Repeat("a", valueobject.MaxNameLength+1)),
+			fmt.Sprintf(valueobject.ErrMsgNameTooLong, valueobject.MaxNameLength)},
+		{"reserved name", valueobject.CreatePerson("admin"),
+			fmt.Sprintf(valueobject.ErrMsgReservedName, "admin")},
+		{"empty title", valueobject.NewPersonBuilder().WithName("Alice").WithTitle("").Build(),
+			valueobject.ErrMsgEmptyTitle},
+		{"title too long", valueobject.NewPersonBuilder().WithName("Alice").
+			WithTitle(strings.Repeat("t", valueobject.MaxTitleLength+1)).Build(),
+			fmt.Sprintf(valueobject.ErrMsgTitleTooLong, valueobject.MaxTitleLength)},
+	}
+	for _, tc := range cases {
+		tf.AssertError(tc.name+" - ValidationError", tc.result, domerr.ValidationError)
+		if tc.result.IsError() {
+			tf.AssertEqual(tc.name+" - message", tc.result.ErrorInfo().Message, tc.want)
+		}
+	}
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
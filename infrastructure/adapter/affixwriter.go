@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Writer decorator that wraps messages in a prefix and suffix
+
+package adapter
+
+import (
+	"context"
+
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	"github.com/abitofhelp/hybrid_app_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+// AffixWriter is a decorator that writes prefix + message + suffix.
+//
+// Affixes are concatenated verbatim; include any separating spaces in
+// them, e.g., prefix "[greeting] " and suffix " [/greeting]".
+//
+// Implements: outbound.WriterPort
+type AffixWriter[W outbound.WriterPort] struct {
+	inner  W
+	prefix string
+	suffix string
+}
+
+// NewAffixWriter wraps w so every message is surrounded by prefix and suffix.
+//
+// Usage:
+//
+//	writer := adapter.NewAffixWriter(adapter.NewConsoleWriter(), "[greeting] ", " [/greeting]")
+//	writer.Write(ctx, "Hello, Alice!")
+//	// stdout: [greeting] Hello, Alice! [/greeting]
+func NewAffixWriter[W outbound.WriterPort](w W, prefix, suffix string) *AffixWriter[W] {
+	return &AffixWriter[W]{inner: w, prefix: prefix, suffix: suffix}
+}
+
+// Write decorates message and delegates.
+//
+// Contract:
+//   - Post: The wrapped writer receives prefix + message + suffix
+//   - Post: The wrapped writer's Result is returned unchanged
+func (aw *AffixWriter[W]) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	return aw.inner.Write(ctx, aw.prefix+message+aw.suffix)
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter_test
+
+import (
+	"context"
+	"testing"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/infrastructure/adapter"
+)
+
+// TestInfrastructureAdapterAffixWriter tests the prefix/suffix decorator.
+func TestInfrastructureAdapterAffixWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.AffixWriter")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Prefix and suffix wrap the message verbatim
+	// ========================================================================
+
+	inner := &recordingWriter{}
+	result := adapter.NewAffixWriter(inner, "[greeting] ", " [/greeting]").Write(ctx, "Hello, Alice!")
+	tf.RunTest("Affix - Write returns Ok", result.IsOk())
+	tf.AssertEqual("Affix - decorated message",
+		inner.messages, []string{"[greeting] Hello, Alice! [/greeting]"})
+
+	// ========================================================================
+	// Test: Empty affixes reproduce the message
+	// ========================================================================
+
+	inner = &recordingWriter{}
+	adapter.NewAffixWriter(inner, "", "").Write(ctx, "Hello, Alice!")
+	tf.AssertEqual("Empty - unchanged", inner.messages, []string{"Hello, Alice!"})
+
+	// ========================================================================
+	// Test: Inner failure propagates unchanged
+	// ========================================================================
+
+	failed := adapter.NewAffixWriter(&failingWriter{}, "<", ">").Write(ctx, "Hi")
+	tf.AssertError("Failure - error propagated", failed, apperr.InfrastructureError)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Event sink that appends an audit line per domain event
+
+package adapter
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/abitofhelp/hybrid_app_go/application/port/outbound"
+	"github.com/abitofhelp/hybrid_app_go/domain/event"
+)
+
+// NewAuditLogger returns an event sink that appends one line per
+// PersonGreeted event to w:
+//
+//	2025-01-02T03:04:05Z greeted Alice
+//
+// Timestamps are the event's time in UTC, RFC 3339.
+//
+// Failure Policy: The greeting has already been delivered when the event
+// fires, so an audit write failure must not turn it into an error. Failures
+// are swallowed and, if failures is non-nil, logged there (tagged with the
+// infrastructure layer and the request ID) so they are not silent.
+//
+// Usage:
+//
+//	audit, _ := os.OpenFile("audit.log", os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+//	uc := usecase.NewGreetUseCase(writer,
+//	    usecase.WithEventSink(adapter.NewAuditLogger(audit, logSink)))
+//
+// Contract:
+//   - Post: Each event produces exactly one write of one complete line
+//   - Post: Safe for concurrent use; lines are never interleaved
+//   - Post: Never panics or reports failure to the caller
+func NewAuditLogger(w io.Writer, failures outbound.LoggerPort) outbound.EventSinkFunc {
+	var mu sync.Mutex
+	log := func(context.Context, string) {}
+	if failures != nil {
+		log = outbound.LogWith(failures, outbound.LayerInfrastructure)
+	}
+
+	return func(ctx context.Context, e event.PersonGreeted) {
+		line := e.At.UTC().Format(time.RFC3339) + " greeted " + e.Name + "\n"
+
+		mu.Lock()
+		_, err := io.WriteString(w, line)
+		mu.Unlock()
+
+		if err != nil {
+			log(ctx, "audit write failed: "+err.Error())
+		}
+	}
+}
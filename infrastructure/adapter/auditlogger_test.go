@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_app_go/application/command"
+	appctx "github.com/abitofhelp/hybrid_app_go/application/context"
+	"github.com/abitofhelp/hybrid_app_go/application/port/outbound"
+	"github.com/abitofhelp/hybrid_app_go/application/usecase"
+	"github.com/abitofhelp/hybrid_app_go/domain/event"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/infrastructure/adapter"
+)
+
+// TestInfrastructureAdapterAuditLogger tests the audit-log event sink.
+func TestInfrastructureAdapterAuditLogger(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.AuditLogger")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Audit line format
+	// ========================================================================
+
+	var buf bytes.Buffer
+	audit := adapter.NewAuditLogger(&buf, nil)
+	audit(ctx, event.PersonGreeted{Name: "Alice", At: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)})
+	tf.AssertEqual("Format - RFC 3339 UTC, then name", buf.String(), "2025-01-02T03:04:05Z greeted Alice\n")
+
+	buf.Reset()
+	pst := time.FixedZone("PST", -8*60*60)
+	audit(ctx, event.PersonGreeted{Name: "José García", At: time.Date(2025, 1, 1, 19, 4, 5, 0, pst)})
+	tf.AssertEqual("Format - converted to UTC", buf.String(), "2025-01-02T03:04:05Z greeted José García\n")
+
+	// ========================================================================
+	// Test: Wired into the use case with a fixed clock
+	// ========================================================================
+
+	buf.Reset()
+	uc := usecase.NewGreetUseCase(&recordingWriter{},
+		usecase.WithEventSink(adapter.NewAuditLogger(&buf, nil)),
+		usecase.WithClock(fixedClock{at: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)}))
+	uc.Execute(ctx, command.NewGreetCommand("Bob"))
+	uc.Execute(ctx, command.NewGreetCommand(""))
+	tf.AssertEqual("Use case - one line per successful greeting",
+		buf.String(), "2025-01-02T03:04:05Z greeted Bob\n")
+
+	// ========================================================================
+	// Test: Sink failure is swallowed and logged, not surfaced
+	// ========================================================================
+
+	logs := adapter.NewInMemoryLogger()
+	writer := &recordingWriter{}
+	uc = usecase.NewGreetUseCase(writer, usecase.WithEventSink(adapter.NewAuditLogger(errIOWriter{}, logs)))
+	result := uc.Execute(appctx.WithRequestID(ctx, "req-7"), command.NewGreetCommand("Alice"))
+	tf.RunTest("Failure - greeting still Ok", result.IsOk())
+	tf.AssertEqual("Failure - greeting still written", writer.messages, []string{"Hello, Alice!"})
+	tf.AssertEqual("Failure - logged once", logs.Entries(), []outbound.LogEntry{{
+		Layer:     outbound.LayerInfrastructure,
+		RequestID: "req-7",
+		Message:   "audit write failed: broken pipe",
+	}})
+
+	tf.RunTest("Failure without logger - Ok",
+		usecase.NewGreetUseCase(&recordingWriter{}, usecase.WithEventSink(adapter.NewAuditLogger(errIOWriter{}, nil))).
+			Execute(ctx, command.NewGreetCommand("Alice")).IsOk())
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
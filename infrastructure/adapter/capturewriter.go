@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Writer adapter that captures messages in memory
+
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+// WriterFunc adapts an ordinary function to the WriterPort, in the style
+// of http.HandlerFunc.
+//
+// Implements: outbound.WriterPort
+type WriterFunc func(ctx context.Context, message string) domerr.Result[model.Unit]
+
+// Write calls f(ctx, message).
+func (f WriterFunc) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	return f(ctx, message)
+}
+
+// NewCaptureWriter returns a writer that appends each message, followed by
+// a newline (as ConsoleWriter does), to an in-memory buffer, plus an
+// accessor for the text captured so far.
+//
+// This is the primitive non-CLI adapters (HTTP handlers, tests) use to put
+// the greeting in their response instead of redirecting os.Stdout.
+// The writer and accessor are safe for concurrent use.
+//
+// Usage:
+//
+//	writer, captured := adapter.NewCaptureWriter()
+//	uc := usecase.NewGreetUseCase(writer)
+//	uc.Execute(ctx, cmd)
+//	body := captured() // "Hello, Alice!\n"
+//
+// Contract:
+//   - Post: Write returns Ok(Unit) and appends message unless ctx is cancelled
+//   - Post: Write returns Err(InfrastructureError) and appends nothing if
+//     ctx is cancelled
+func NewCaptureWriter() (WriterFunc, func() string) {
+	var (
+		mu  sync.Mutex
+		buf strings.Builder
+	)
+
+	write := func(ctx context.Context, message string) domerr.Result[model.Unit] {
+		if ctx.Err() != nil {
+			return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				withRequestID(ctx, fmt.Sprintf("write cancelled: %v", ctx.Err()))))
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		buf.WriteString(message)
+		buf.WriteByte('\n')
+		return domerr.Ok(model.UnitValue)
+	}
+
+	captured := func() string {
+		mu.Lock()
+		defer mu.Unlock()
+		return buf.String()
+	}
+
+	return write, captured
+}
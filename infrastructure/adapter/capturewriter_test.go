@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter_test
+
+import (
+	"context"
+	"testing"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	"github.com/abitofhelp/hybrid_app_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/infrastructure/adapter"
+)
+
+// Compile-time check: WriterFunc satisfies the WriterPort.
+var _ outbound.WriterPort = adapter.WriterFunc(nil)
+
+// TestInfrastructureAdapterCaptureWriter tests the in-memory capture writer.
+func TestInfrastructureAdapterCaptureWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.CaptureWriter")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Nothing captured before the first write
+	// ========================================================================
+
+	writer, captured := adapter.NewCaptureWriter()
+	tf.AssertEqual("Empty - captured", captured(), "")
+
+	// ========================================================================
+	// Test: Single write
+	// ========================================================================
+
+	result := writer.Write(ctx, "Hello, Alice!")
+	tf.RunTest("Single - Write returns Ok", result.IsOk())
+	tf.AssertEqual("Single - captured", captured(), "Hello, Alice!\n")
+
+	// ========================================================================
+	// Test: Multiple writes accumulate in order
+	// ========================================================================
+
+	writer.Write(ctx, "Hello, Bob!")
+	writer.Write(ctx, "Hello, Carol!")
+	tf.AssertEqual("Multiple - captured", captured(),
+		"Hello, Alice!\nHello, Bob!\nHello, Carol!\n")
+
+	// ========================================================================
+	// Test: Cancelled context returns InfrastructureError and captures nothing
+	// ========================================================================
+
+	writer, captured = adapter.NewCaptureWriter()
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	cancelled := writer.Write(cancelledCtx, "Hello, Alice!")
+	tf.AssertError("Cancelled - InfrastructureError", cancelled, apperr.InfrastructureError)
+	if cancelled.IsError() {
+		tf.AssertEqual("Cancelled - message",
+			cancelled.ErrorInfo().Message, "write cancelled: context canceled")
+	}
+	tf.AssertEqual("Cancelled - nothing captured", captured(), "")
+
+	// ========================================================================
+	// Test: WriterFunc delegates to the wrapped function
+	// ========================================================================
+
+	var got string
+	fn := adapter.WriterFunc(func(_ context.Context, message string) domerr.Result[model.Unit] {
+		got = message
+		return domerr.Ok(model.UnitValue)
+	})
+	tf.RunTest("WriterFunc - returns Ok", fn.Write(ctx, "Hi").IsOk())
+	tf.AssertEqual("WriterFunc - received message", got, "Hi")
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Writer adapter that sends messages on a channel
+
+package adapter
+
+import (
+	"context"
+	"fmt"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+// NewChannelWriter returns a writer that sends each message on ch, so a
+// consumer goroutine can handle output in a pipeline.
+//
+// Messages are sent as given (no trailing newline). Write blocks while ch
+// is full (or, if unbuffered, until the consumer receives), but never past
+// cancellation of its context. The caller owns ch and closes it once
+// writing is done.
+//
+// Usage:
+//
+//	ch := make(chan string, 16)
+//	go func() {
+//	    for msg := range ch {
+//	        // consume msg
+//	    }
+//	}()
+//	uc := usecase.NewGreetAllUseCase(adapter.NewChannelWriter(ch))
+//
+// Contract:
+//   - Pre: ch is not closed while Write may be called
+//   - Post: Returns Ok(Unit) once message has been sent
+//   - Post: Returns Err(InfrastructureError) without sending if ctx is
+//     cancelled before or while blocked on the channel
+//   - Post: Messages from sequential writes arrive in write order
+func NewChannelWriter(ch chan<- string) WriterFunc {
+	return func(ctx context.Context, message string) domerr.Result[model.Unit] {
+		// Check first: select would pick randomly between a ready send and
+		// an already-cancelled context.
+		if ctx.Err() != nil {
+			return channelCancelled(ctx)
+		}
+		select {
+		case ch <- message:
+			return domerr.Ok(model.UnitValue)
+		case <-ctx.Done():
+			return channelCancelled(ctx)
+		}
+	}
+}
+
+// channelCancelled maps ctx's error to an InfrastructureError Result.
+func channelCancelled(ctx context.Context) domerr.Result[model.Unit] {
+	return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+		withRequestID(ctx, fmt.Sprintf("write cancelled: %v", ctx.Err()))))
+}
@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/infrastructure/adapter"
+)
+
+// TestInfrastructureAdapterChannelWriter tests the channel-backed writer.
+func TestInfrastructureAdapterChannelWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.ChannelWriter")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Buffered channel receives the message
+	// ========================================================================
+
+	ch := make(chan string, 2)
+	writer := adapter.NewChannelWriter(ch)
+	tf.RunTest("Buffered - Write returns Ok", writer.Write(ctx, "Hello, Alice!").IsOk())
+	tf.AssertEqual("Buffered - message sent verbatim", <-ch, "Hello, Alice!")
+
+	// ========================================================================
+	// Test: Order is preserved for a consumer goroutine
+	// ========================================================================
+
+	const count = 100
+	unbuffered := make(chan string)
+	received := make(chan []string)
+	go func() {
+		var got []string
+		for msg := range unbuffered {
+			got = append(got, msg)
+		}
+		received <- got
+	}()
+
+	writer = adapter.NewChannelWriter(unbuffered)
+	failures := 0
+	for i := 0; i < count; i++ {
+		if writer.Write(ctx, fmt.Sprintf("Hello, %03d!", i)).IsError() {
+			failures++
+		}
+	}
+	close(unbuffered)
+	got := <-received
+
+	want := make([]string, count)
+	for i := range want {
+		want[i] = fmt.Sprintf("Hello, %03d!", i)
+	}
+	tf.AssertEqual("Order - no failures", failures, 0)
+	tf.AssertEqual("Order - received in write order", got, want)
+
+	// ========================================================================
+	// Test: Cancellation while blocked on a full channel
+	// ========================================================================
+
+	full := make(chan string, 1)
+	full <- "occupied"
+	writer = adapter.NewChannelWriter(full)
+
+	blockedCtx, cancel := context.WithCancel(ctx)
+	done := make(chan bool)
+	go func() {
+		result := writer.Write(blockedCtx, "Hello, Bob!")
+		done <- result.IsError() && result.ErrorInfo().Kind == apperr.InfrastructureError &&
+			strings.HasPrefix(result.ErrorInfo().Message, "write cancelled: ")
+	}()
+
+	select {
+	case <-done:
+		tf.RunTest("Full - Write blocks until cancelled", false)
+	case <-time.After(20 * time.Millisecond):
+		tf.RunTest("Full - Write blocks until cancelled", true)
+	}
+	cancel()
+	tf.RunTest("Full - cancelled Write returns InfrastructureError", <-done)
+	tf.AssertEqual("Full - nothing sent", len(full), 1)
+	tf.AssertEqual("Full - original message intact", <-full, "occupied")
+
+	// ========================================================================
+	// Test: Already-cancelled context never sends, even with room
+	// ========================================================================
+
+	roomy := make(chan string, 10)
+	cancelled, cancelNow := context.WithCancel(ctx)
+	cancelNow()
+	writer = adapter.NewChannelWriter(roomy)
+	for i := 0; i < 20; i++ {
+		writer.Write(cancelled, "Hello, Carol!")
+	}
+	tf.AssertError("Cancelled - InfrastructureError",
+		writer.Write(cancelled, "Hello, Carol!"), apperr.InfrastructureError)
+	tf.AssertEqual("Cancelled - nothing sent", len(roomy), 0)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
@@ -0,0 +1,18 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: System clock adapter
+
+package adapter
+
+import "time"
+
+// SystemClock is an infrastructure adapter that reads the wall clock.
+//
+// Implements: outbound.ClockPort
+type SystemClock struct{}
+
+// Now returns the current local time.
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
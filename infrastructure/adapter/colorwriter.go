@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Console writer that colors greetings with ANSI escapes
+
+package adapter
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+// ANSI escape sequences used by NewColorWriter.
+const (
+	ansiGreen = "\x1b[32m"
+	ansiReset = "\x1b[0m"
+)
+
+// EnvNoColor is the conventional variable (https://no-color.org) that
+// disables colored output when set to a non-empty value.
+const EnvNoColor = "NO_COLOR"
+
+// NewColorWriter returns a writer that writes each message to w as
+// ConsoleWriter does, wrapped in green ANSI color codes when enable is
+// true. With enable false the output is byte-for-byte ConsoleWriter's.
+//
+// Decide enable with ColorEnabled rather than hard-coding it, so piped
+// output and NO_COLOR users never receive escape codes.
+//
+// Usage:
+//
+//	writer := adapter.NewColorWriter(os.Stdout, adapter.ColorEnabled(os.Stdout))
+//
+// Contract:
+//   - Same as ConsoleWriter.Write (cancellation, I/O errors, panics)
+//   - Post: When enabled, each line is ansiGreen + message + ansiReset + "\n"
+func NewColorWriter(w io.Writer, enable bool) WriterFunc {
+	console := NewWriter(w)
+	if !enable {
+		return console.Write
+	}
+	return func(ctx context.Context, message string) domerr.Result[model.Unit] {
+		return console.Write(ctx, ansiGreen+message+ansiReset)
+	}
+}
+
+// ColorEnabled reports whether colored output should be written to f:
+// only when f is a terminal and NO_COLOR is unset or empty.
+//
+// Terminal detection uses only the standard library (os.ModeCharDevice),
+// so it works on Windows as well as Unix without cgo or syscalls. It
+// cannot tell a console from other character devices (e.g., os.DevNull),
+// which is harmless: nothing reads the escapes there. Consoles on Windows
+// 10 and later interpret ANSI escapes; older consoles will show them.
+func ColorEnabled(f *os.File) bool {
+	if os.Getenv(EnvNoColor) != "" {
+		return false
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/infrastructure/adapter"
+)
+
+// TestInfrastructureAdapterColorWriter tests ANSI coloring and TTY detection.
+func TestInfrastructureAdapterColorWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.ColorWriter")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Enabled - message wrapped in color codes
+	// ========================================================================
+
+	var buf bytes.Buffer
+	result := adapter.NewColorWriter(&buf, true).Write(ctx, "Hello, Alice!")
+	tf.RunTest("Enabled - IsOk", result.IsOk())
+	tf.AssertEqual("Enabled - colored line", buf.String(), "\x1b[32mHello, Alice!\x1b[0m\n")
+
+	// ========================================================================
+	// Test: Disabled - plain ConsoleWriter output
+	// ========================================================================
+
+	buf.Reset()
+	adapter.NewColorWriter(&buf, false).Write(ctx, "Hello, Alice!")
+	tf.AssertEqual("Disabled - plain line", buf.String(), "Hello, Alice!\n")
+
+	// ========================================================================
+	// Test: ColorEnabled - not a terminal
+	// ========================================================================
+
+	t.Setenv(adapter.EnvNoColor, "")
+	file, err := os.Create(filepath.Join(t.TempDir(), "out.txt"))
+	if err != nil {
+		t.Fatalf("create temp file: %v", err)
+	}
+	defer file.Close()
+	tf.RunTest("Regular file - disabled", !adapter.ColorEnabled(file))
+
+	buf.Reset()
+	adapter.NewColorWriter(&buf, adapter.ColorEnabled(file)).Write(ctx, "Hello, Alice!")
+	tf.RunTest("Regular file - no escape codes", !strings.Contains(buf.String(), "\x1b["))
+
+	// ========================================================================
+	// Test: ColorEnabled - character device, with and without NO_COLOR
+	// ========================================================================
+
+	// os.DevNull is a character device on Unix and Windows, standing in
+	// for a terminal
+	device, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatalf("open %s: %v", os.DevNull, err)
+	}
+	defer device.Close()
+	tf.RunTest("Character device - enabled", adapter.ColorEnabled(device))
+
+	t.Setenv(adapter.EnvNoColor, "1")
+	tf.RunTest("NO_COLOR set - disabled", !adapter.ColorEnabled(device))
+	buf.Reset()
+	adapter.NewColorWriter(&buf, adapter.ColorEnabled(device)).Write(ctx, "Hello, Alice!")
+	tf.AssertEqual("NO_COLOR set - no escape codes", buf.String(), "Hello, Alice!\n")
+
+	// ========================================================================
+	// Test: ColorEnabled - closed file cannot be inspected
+	// ========================================================================
+
+	t.Setenv(adapter.EnvNoColor, "")
+	closed, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatalf("open %s: %v", os.DevNull, err)
+	}
+	closed.Close()
+	tf.RunTest("Closed file - disabled", !adapter.ColorEnabled(closed))
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
@@ -55,7 +55,9 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"runtime/debug"
 
+	appctx "github.com/abitofhelp/hybrid_app_go/application/context"
 	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
 	"github.com/abitofhelp/hybrid_app_go/application/model"
 	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
@@ -78,7 +80,40 @@ import (
 //
 // Implements: outbound.WriterPort
 type ConsoleWriter struct {
-	w io.Writer
+	w          io.Writer
+	opts       WriterOptions
+	bomPending bool
+}
+
+// LineEnding selects the terminator ConsoleWriter appends to each message.
+type LineEnding int
+
+const (
+	// LineEndingLF terminates lines with "\n" (default).
+	LineEndingLF LineEnding = iota
+	// LineEndingCRLF terminates lines with "\r\n" (Windows tools).
+	LineEndingCRLF
+)
+
+// utf8BOM is the UTF-8 byte order mark.
+const utf8BOM = "\uFEFF"
+
+// WriterOptions controls how ConsoleWriter encodes its output.
+// The zero value matches NewWriter: LF line endings, no BOM.
+type WriterOptions struct {
+	// LineEnding is appended after every message.
+	LineEnding LineEnding
+
+	// BOM emits a UTF-8 byte order mark before the first message only.
+	BOM bool
+}
+
+// terminator returns the line ending string for the configured option.
+func (o WriterOptions) terminator() string {
+	if o.LineEnding == LineEndingCRLF {
+		return "\r\n"
+	}
+	return "\n"
 }
 
 // NewWriter creates a ConsoleWriter that writes to the provided io.Writer.
@@ -118,7 +153,20 @@ type ConsoleWriter struct {
 //	writer := NewWriter(file)
 //	result := writer.Write(ctx, "Hello!")
 func NewWriter(w io.Writer) *ConsoleWriter {
-	return &ConsoleWriter{w: w}
+	return NewWriterWithOptions(w, WriterOptions{})
+}
+
+// NewWriterWithOptions creates a ConsoleWriter with explicit output encoding,
+// e.g., CRLF line endings and a leading BOM for Windows editors.
+//
+// Usage:
+//
+//	writer := adapter.NewWriterWithOptions(file, adapter.WriterOptions{
+//	    LineEnding: adapter.LineEndingCRLF,
+//	    BOM:        true,
+//	})
+func NewWriterWithOptions(w io.Writer, opts WriterOptions) *ConsoleWriter {
+	return &ConsoleWriter{w: w, opts: opts, bomPending: opts.BOM}
 }
 
 // Write writes the message to the underlying io.Writer.
@@ -138,9 +186,11 @@ func NewWriter(w io.Writer) *ConsoleWriter {
 //   - Enables graceful shutdown and timeout support
 //
 // Error Handling:
-//   - Recovers from panics and converts to InfrastructureError
+//   - Recovers from panics and converts to InfrastructureError; the
+//     panicking goroutine's stack is attached for logs (ErrorType.Stack)
 //   - Maps all io.Writer errors to InfrastructureError
 //   - Includes original error message for debugging
+//   - Includes the request ID from ctx (if any) for log correlation
 //
 // Contract:
 //   - ctx parameter carries cancellation and deadline signals
@@ -156,7 +206,7 @@ func (cw *ConsoleWriter) Write(ctx context.Context, message string) (result dome
 	defer func() {
 		if r := recover(); r != nil {
 			result = domerr.Err[model.Unit](apperr.NewInfrastructureError(
-				fmt.Sprintf("write panicked: %v", r)))
+				withRequestID(ctx, fmt.Sprintf("write panicked: %v", r))).WithStack(debug.Stack()))
 		}
 	}()
 
@@ -165,26 +215,40 @@ func (cw *ConsoleWriter) Write(ctx context.Context, message string) (result dome
 	select {
 	case <-ctx.Done():
 		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
-			fmt.Sprintf("write cancelled: %v", ctx.Err())))
+			withRequestID(ctx, fmt.Sprintf("write cancelled: %v", ctx.Err()))))
 	default:
 		// Context is still active, proceed with I/O
 	}
 
-	// Perform the I/O operation using the injected writer
-	// fmt.Fprintln handles the newline and returns any write errors
-	_, err := fmt.Fprintln(cw.w, message)
+	// Perform the I/O operation using the injected writer.
+	// BOM (first write only), message, and terminator go out in one call;
+	// the BOM stays pending until a write succeeds.
+	prefix := ""
+	if cw.bomPending {
+		prefix = utf8BOM
+	}
+	_, err := io.WriteString(cw.w, prefix+message+cw.opts.terminator())
 	if err != nil {
 		// Map the I/O error to a domain InfrastructureError
 		// This keeps infrastructure concerns (specific error types)
 		// from leaking into application/domain layers
 		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
-			fmt.Sprintf("write failed: %v", err)))
+			withRequestID(ctx, fmt.Sprintf("write failed: %v", err))))
 	}
 
 	// Success case - return Unit to indicate completion
+	cw.bomPending = false
 	return domerr.Ok(model.UnitValue)
 }
 
+// withRequestID appends " [request_id=<id>]" to message when ctx carries one.
+func withRequestID(ctx context.Context, message string) string {
+	if id, ok := appctx.RequestIDFrom(ctx); ok {
+		return message + " [request_id=" + id + "]"
+	}
+	return message
+}
+
 // NewConsoleWriter creates a ConsoleWriter that writes to standard output.
 //
 // This is a convenience function that wraps NewWriter with os.Stdout.
@@ -215,3 +279,19 @@ func NewConsoleWriter() *ConsoleWriter {
 func NewStderrWriter() *ConsoleWriter {
 	return NewWriter(os.Stderr)
 }
+
+// NewSplitWriter creates a pair of ConsoleWriters: greet writes to out
+// (normally stdout) and diag writes to diagErr (normally stderr).
+//
+// Composition roots use the pair so that both the result stream and the
+// diagnostic stream go through WriterPort adapters, with the same error
+// mapping, instead of printing to os.Stderr directly.
+//
+// Usage:
+//
+//	greet, diag := adapter.NewSplitWriter(os.Stdout, os.Stderr)
+//	uc := usecase.NewGreetUseCase(greet)
+//	diag.Write(ctx, "warning: falling back to default locale")
+func NewSplitWriter(out, diagErr io.Writer) (greet, diag *ConsoleWriter) {
+	return NewWriter(out), NewWriter(diagErr)
+}
@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	appctx "github.com/abitofhelp/hybrid_app_go/application/context"
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/infrastructure/adapter"
+)
+
+// errIOWriter is an io.Writer that always fails.
+type errIOWriter struct{}
+
+func (errIOWriter) Write(_ []byte) (int, error) {
+	return 0, errors.New("broken pipe")
+}
+
+// panickingIOWriter is an io.Writer that panics on every write.
+type panickingIOWriter struct{}
+
+func (panickingIOWriter) Write(_ []byte) (int, error) {
+	panic("sink exploded")
+}
+
+// TestInfrastructureAdapterConsoleWriter tests the io.Writer-backed adapter.
+func TestInfrastructureAdapterConsoleWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.ConsoleWriter")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Successful write appends newline
+	// ========================================================================
+
+	var buf bytes.Buffer
+	result := adapter.NewWriter(&buf).Write(ctx, "Hello, Alice!")
+	tf.RunTest("Write - returns Ok", result.IsOk())
+	tf.AssertEqual("Write - output has trailing newline", buf.String(), "Hello, Alice!\n")
+
+	// ========================================================================
+	// Test: I/O failure maps to InfrastructureError
+	// ========================================================================
+
+	failed := adapter.NewWriter(errIOWriter{}).Write(ctx, "Hello, Alice!")
+	tf.AssertError("Write failure - InfrastructureError", failed, apperr.InfrastructureError)
+	if failed.IsError() {
+		tf.AssertEqual("Write failure - message has cause",
+			failed.ErrorInfo().Message, "write failed: broken pipe")
+	}
+
+	// ========================================================================
+	// Test: Request ID from context appears in error messages
+	// ========================================================================
+
+	reqCtx := appctx.WithRequestID(ctx, "req-42")
+	failed = adapter.NewWriter(errIOWriter{}).Write(reqCtx, "Hello, Alice!")
+	if failed.IsError() {
+		tf.AssertEqual("Request ID - included in write failure",
+			failed.ErrorInfo().Message, "write failed: broken pipe [request_id=req-42]")
+	} else {
+		tf.RunTest("Request ID - Result should be Error", false)
+	}
+
+	cancelled, cancel := context.WithCancel(reqCtx)
+	cancel()
+	buf.Reset()
+	failed = adapter.NewWriter(&buf).Write(cancelled, "Hello, Alice!")
+	tf.AssertError("Cancelled - InfrastructureError", failed, apperr.InfrastructureError)
+	if failed.IsError() {
+		tf.RunTest("Cancelled - message includes request ID",
+			strings.HasSuffix(failed.ErrorInfo().Message, "[request_id=req-42]"))
+	}
+	tf.AssertEqual("Cancelled - nothing written", buf.String(), "")
+
+	// ========================================================================
+	// Test: Panic is recovered with a concise message and a debug stack
+	// ========================================================================
+
+	panicked := adapter.NewWriter(panickingIOWriter{}).Write(ctx, "Hello, Alice!")
+	tf.AssertError("Panic - InfrastructureError", panicked, apperr.InfrastructureError)
+	if panicked.IsError() {
+		info := panicked.ErrorInfo()
+		tf.AssertEqual("Panic - concise message", info.Message, "write panicked: sink exploded")
+		tf.RunTest("Panic - stack is non-empty", info.Stack() != "")
+		tf.RunTest("Panic - stack names the panicking writer",
+			strings.Contains(info.Stack(), "panickingIOWriter.Write"))
+		tf.RunTest("Panic - stack not in Error()", !strings.Contains(info.Error(), "goroutine"))
+	}
+	tf.AssertEqual("No panic - no stack", failed.ErrorInfo().Stack(), "")
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
+
+// TestInfrastructureAdapterConsoleWriterOptions tests line ending and BOM options.
+func TestInfrastructureAdapterConsoleWriterOptions(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.ConsoleWriter.Options")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Zero options match NewWriter (LF, no BOM)
+	// ========================================================================
+
+	var buf bytes.Buffer
+	adapter.NewWriterWithOptions(&buf, adapter.WriterOptions{}).Write(ctx, "Hi")
+	tf.AssertEqual("Default - LF without BOM", buf.String(), "Hi\n")
+
+	// ========================================================================
+	// Test: CRLF line endings
+	// ========================================================================
+
+	buf.Reset()
+	crlf := adapter.NewWriterWithOptions(&buf, adapter.WriterOptions{LineEnding: adapter.LineEndingCRLF})
+	crlf.Write(ctx, "Hello, Alice!")
+	crlf.Write(ctx, "Hello, Bob!")
+	tf.AssertEqual("CRLF - every line terminated", buf.String(), "Hello, Alice!\r\nHello, Bob!\r\n")
+
+	// ========================================================================
+	// Test: BOM on first write only
+	// ========================================================================
+
+	buf.Reset()
+	bom := adapter.NewWriterWithOptions(&buf, adapter.WriterOptions{BOM: true, LineEnding: adapter.LineEndingCRLF})
+	bom.Write(ctx, "one")
+	bom.Write(ctx, "two")
+	tf.AssertEqual("BOM - single leading BOM", buf.String(), "\uFEFFone\r\ntwo\r\n")
+	tf.AssertEqual("BOM - exactly one BOM", strings.Count(buf.String(), "\uFEFF"), 1)
+
+	// ========================================================================
+	// Test: Cancellation aborts before the BOM is written
+	// ========================================================================
+
+	buf.Reset()
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	bom = adapter.NewWriterWithOptions(&buf, adapter.WriterOptions{BOM: true})
+	tf.AssertError("Cancelled - InfrastructureError", bom.Write(cancelled, "one"), apperr.InfrastructureError)
+	tf.AssertEqual("Cancelled - nothing written (no BOM)", buf.Len(), 0)
+	bom.Write(ctx, "one")
+	tf.AssertEqual("Cancelled - BOM still emitted on first successful write", buf.String(), "\uFEFFone\n")
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
+
+// TestInfrastructureAdapterSplitWriter tests the stdout/stderr writer pair.
+func TestInfrastructureAdapterSplitWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.SplitWriter")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Each writer targets its own sink
+	// ========================================================================
+
+	var out, diagOut bytes.Buffer
+	greet, diag := adapter.NewSplitWriter(&out, &diagOut)
+	tf.RunTest("Greet - returns Ok", greet.Write(ctx, "Hello, Alice!").IsOk())
+	tf.RunTest("Diag - returns Ok", diag.Write(ctx, "warning: slow sink").IsOk())
+	tf.AssertEqual("Greet - only greeting on out", out.String(), "Hello, Alice!\n")
+	tf.AssertEqual("Diag - only diagnostic on err", diagOut.String(), "warning: slow sink\n")
+
+	// ========================================================================
+	// Test: Both writers map I/O failures to InfrastructureError
+	// ========================================================================
+
+	greet, diag = adapter.NewSplitWriter(errIOWriter{}, errIOWriter{})
+	tf.AssertError("Greet failure - InfrastructureError",
+		greet.Write(ctx, "Hello, Alice!"), apperr.InfrastructureError)
+	tf.AssertError("Diag failure - InfrastructureError",
+		diag.Write(ctx, "warning: slow sink"), apperr.InfrastructureError)
+
+	// A failing diagnostic sink does not affect the greeting sink
+	out.Reset()
+	greet, diag = adapter.NewSplitWriter(&out, errIOWriter{})
+	diag.Write(ctx, "warning: slow sink")
+	tf.RunTest("Independent - greet still Ok", greet.Write(ctx, "Hello, Bob!").IsOk())
+	tf.AssertEqual("Independent - greeting written", out.String(), "Hello, Bob!\n")
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
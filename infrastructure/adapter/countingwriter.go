@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Writer decorator that counts successful and failed writes
+
+package adapter
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	"github.com/abitofhelp/hybrid_app_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+// CountingWriter is a decorator that tracks how many writes succeeded and
+// how many failed, without altering the wrapped writer's behaviour.
+//
+// Counters are updated atomically, so a CountingWriter may be shared
+// across goroutines as long as the wrapped writer is.
+//
+// Implements: outbound.WriterPort
+type CountingWriter[W outbound.WriterPort] struct {
+	inner    W
+	writes   atomic.Int64
+	failures atomic.Int64
+}
+
+// NewCountingWriter wraps w with write/failure counters.
+//
+// Usage:
+//
+//	writer := adapter.NewCountingWriter(adapter.NewConsoleWriter())
+//	writer.Write(ctx, "Hello, Alice!")
+//	writes, failures := writer.Counts() // 1, 0
+func NewCountingWriter[W outbound.WriterPort](w W) *CountingWriter[W] {
+	return &CountingWriter[W]{inner: w}
+}
+
+// Write delegates to the wrapped writer and counts the outcome.
+//
+// Contract:
+//   - Post: The wrapped writer's Result is returned unchanged
+//   - Post: writes is incremented on Ok, failures on Err
+func (cw *CountingWriter[W]) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	result := cw.inner.Write(ctx, message)
+	if result.IsOk() {
+		cw.writes.Add(1)
+	} else {
+		cw.failures.Add(1)
+	}
+	return result
+}
+
+// Counts returns the number of successful writes and failed writes so far.
+func (cw *CountingWriter[W]) Counts() (writes, failures int) {
+	return int(cw.writes.Load()), int(cw.failures.Load())
+}
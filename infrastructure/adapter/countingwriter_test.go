@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter_test
+
+import (
+	"context"
+	"testing"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/infrastructure/adapter"
+)
+
+// TestInfrastructureAdapterCountingWriter tests the counting decorator.
+func TestInfrastructureAdapterCountingWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.CountingWriter")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Fresh writer reports zero counts
+	// ========================================================================
+
+	inner := &recordingWriter{}
+	writer := adapter.NewCountingWriter(inner)
+	writes, failures := writer.Counts()
+	tf.AssertEqual("Fresh - writes", writes, 0)
+	tf.AssertEqual("Fresh - failures", failures, 0)
+
+	// ========================================================================
+	// Test: Successes are counted and messages pass through
+	// ========================================================================
+
+	tf.RunTest("Success - Write returns Ok", writer.Write(ctx, "one").IsOk())
+	writer.Write(ctx, "two")
+	writes, failures = writer.Counts()
+	tf.AssertEqual("Success - writes", writes, 2)
+	tf.AssertEqual("Success - failures", failures, 0)
+	tf.AssertEqual("Success - inner received messages", inner.messages, []string{"one", "two"})
+
+	// ========================================================================
+	// Test: Failures are counted and the Result passes through unchanged
+	// ========================================================================
+
+	failing := &failingWriter{}
+	mixed := adapter.NewCountingWriter(failing)
+	failed := mixed.Write(ctx, "x")
+	mixed.Write(ctx, "y")
+	mixed.Write(ctx, "z")
+	writes, failures = mixed.Counts()
+	tf.AssertEqual("Failure - writes", writes, 0)
+	tf.AssertEqual("Failure - failures", failures, 3)
+	tf.AssertEqual("Failure - inner called each time", failing.calls, 3)
+	tf.AssertError("Failure - error propagated", failed, apperr.InfrastructureError)
+	if failed.IsError() {
+		tf.AssertEqual("Failure - message unchanged", failed.ErrorInfo().Message, "sink unavailable")
+	}
+
+	// ========================================================================
+	// Test: Mix of successes and failures through one counter
+	// ========================================================================
+
+	flaky := &flakyWriter{failEvery: 2}
+	counted := adapter.NewCountingWriter(flaky)
+	for i := 0; i < 5; i++ {
+		counted.Write(ctx, "msg")
+	}
+	writes, failures = counted.Counts()
+	tf.AssertEqual("Mixed - writes", writes, 3)
+	tf.AssertEqual("Mixed - failures", failures, 2)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Writer adapter that emits each message as a CSV row
+
+package adapter
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sync"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+// CSVWriter is an infrastructure adapter that writes each message as a
+// single-column CSV row, for piping greetings into spreadsheets.
+//
+// Quoting follows RFC 4180 via encoding/csv: fields containing commas,
+// double quotes, or newlines are quoted and embedded quotes are doubled.
+// Every row is flushed immediately, so no separate flush step is needed.
+//
+// Implements: outbound.WriterPort
+type CSVWriter struct {
+	mu sync.Mutex
+	w  *csv.Writer
+}
+
+// NewCSVWriter creates a CSVWriter that writes rows to w.
+//
+// Usage:
+//
+//	writer := adapter.NewCSVWriter(os.Stdout)
+//	writer.Write(ctx, "Hello, O'Brien, Jr.!")
+//	// stdout: "Hello, O'Brien, Jr.!"
+func NewCSVWriter(w io.Writer) *CSVWriter {
+	return &CSVWriter{w: csv.NewWriter(w)}
+}
+
+// Write emits message as one CSV row and flushes it.
+//
+// Contract:
+//   - Post: Returns Ok(Unit) once the row has been flushed
+//   - Post: Returns Err(InfrastructureError) on I/O failure or cancellation
+//   - Safe for concurrent use; rows are never interleaved
+func (cw *CSVWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	if ctx.Err() != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			withRequestID(ctx, fmt.Sprintf("write cancelled: %v", ctx.Err()))))
+	}
+
+	cw.mu.Lock()
+	defer cw.mu.Unlock()
+
+	if err := cw.w.Write([]string{message}); err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			withRequestID(ctx, fmt.Sprintf("write failed: %v", err))))
+	}
+	cw.w.Flush()
+	if err := cw.w.Error(); err != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			withRequestID(ctx, fmt.Sprintf("write failed: %v", err))))
+	}
+	return domerr.Ok(model.UnitValue)
+}
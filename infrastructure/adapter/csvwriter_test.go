@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/infrastructure/adapter"
+)
+
+// TestInfrastructureAdapterCSVWriter tests the CSV row adapter.
+func TestInfrastructureAdapterCSVWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.CSVWriter")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Plain and quoted rows
+	// ========================================================================
+
+	var buf bytes.Buffer
+	writer := adapter.NewCSVWriter(&buf)
+	tf.RunTest("Plain - Write returns Ok", writer.Write(ctx, "Hello Alice").IsOk())
+	tf.RunTest("Comma - Write returns Ok", writer.Write(ctx, "Hello, O'Brien, Jr.!").IsOk())
+	writer.Write(ctx, `Say "hi"`)
+	writer.Write(ctx, "two\nlines")
+	tf.AssertEqual("Rows - RFC 4180 quoting", buf.String(),
+		"Hello Alice\n"+
+			"\"Hello, O'Brien, Jr.!\"\n"+
+			"\"Say \"\"hi\"\"\"\n"+
+			"\"two\nlines\"\n")
+
+	// ========================================================================
+	// Test: Write error maps to InfrastructureError
+	// ========================================================================
+
+	failed := adapter.NewCSVWriter(errIOWriter{}).Write(ctx, "Hello, Alice!")
+	tf.AssertError("Failure - InfrastructureError", failed, apperr.InfrastructureError)
+	if failed.IsError() {
+		tf.AssertEqual("Failure - message", failed.ErrorInfo().Message, "write failed: broken pipe")
+	}
+
+	// ========================================================================
+	// Test: Cancelled context writes nothing
+	// ========================================================================
+
+	buf.Reset()
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	cancelled := adapter.NewCSVWriter(&buf).Write(cctx, "Hello")
+	tf.AssertError("Cancelled - InfrastructureError", cancelled, apperr.InfrastructureError)
+	tf.AssertEqual("Cancelled - nothing written", buf.Len(), 0)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
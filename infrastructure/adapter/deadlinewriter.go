@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Console-style writer with a per-write deadline
+
+package adapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+// DeadlineWriter writes lines to an io.Writer like ConsoleWriter, but
+// bounds each write by a per-write timeout so a slow sink (pipe, network
+// connection) cannot stall the caller indefinitely.
+//
+// io.Writer has no cancellation, so an expired write is abandoned rather
+// than interrupted: it keeps running in the background and later writes
+// wait for it (that wait counts against their own deadline). Writes are
+// therefore serialized and never overlap on the underlying io.Writer.
+//
+// Implements: outbound.WriterPort
+type DeadlineWriter struct {
+	inner    *ConsoleWriter
+	perWrite time.Duration
+	slot     chan struct{}
+}
+
+// NewWriterWithDeadline creates a writer to w whose every Write must finish
+// within perWrite; perWrite <= 0 means no per-write deadline.
+//
+// Usage:
+//
+//	writer := adapter.NewWriterWithDeadline(conn, 2*time.Second)
+//	result := writer.Write(ctx, "Hello, Alice!")
+func NewWriterWithDeadline(w io.Writer, perWrite time.Duration) *DeadlineWriter {
+	return &DeadlineWriter{inner: NewWriter(w), perWrite: perWrite, slot: make(chan struct{}, 1)}
+}
+
+// Write writes message and a newline, giving up after the per-write
+// deadline.
+//
+// Contract:
+//   - Post: Returns the ConsoleWriter Result if the write finishes in time
+//   - Post: Returns Err(InfrastructureError) citing the timeout if the
+//     deadline passes first
+//   - Post: Returns Err(InfrastructureError) if ctx is cancelled first
+func (dw *DeadlineWriter) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	writeCtx := ctx
+	if dw.perWrite > 0 {
+		var cancel context.CancelFunc
+		writeCtx, cancel = context.WithTimeout(ctx, dw.perWrite)
+		defer cancel()
+	}
+
+	// Wait for any earlier (possibly abandoned) write to finish.
+	select {
+	case dw.slot <- struct{}{}:
+	case <-writeCtx.Done():
+		return dw.expired(ctx, writeCtx)
+	}
+
+	done := make(chan domerr.Result[model.Unit], 1)
+	go func() {
+		defer func() { <-dw.slot }()
+		done <- dw.inner.Write(writeCtx, message)
+	}()
+
+	select {
+	case result := <-done:
+		return result
+	case <-writeCtx.Done():
+		return dw.expired(ctx, writeCtx)
+	}
+}
+
+// expired reports why writeCtx ended: our own deadline (timeout) or the
+// caller's context (cancellation).
+func (dw *DeadlineWriter) expired(ctx, writeCtx context.Context) domerr.Result[model.Unit] {
+	if ctx.Err() == nil && errors.Is(writeCtx.Err(), context.DeadlineExceeded) {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			withRequestID(ctx, fmt.Sprintf("write timed out after %s", dw.perWrite))))
+	}
+	return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+		withRequestID(ctx, fmt.Sprintf("write cancelled: %v", ctx.Err()))))
+}
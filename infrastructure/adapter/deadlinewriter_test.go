@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/infrastructure/adapter"
+)
+
+// blockingIOWriter is an io.Writer that blocks until release is closed.
+type blockingIOWriter struct {
+	release chan struct{}
+	buf     bytes.Buffer
+}
+
+func (w *blockingIOWriter) Write(p []byte) (int, error) {
+	<-w.release
+	return w.buf.Write(p)
+}
+
+// TestInfrastructureAdapterDeadlineWriter tests the per-write deadline.
+func TestInfrastructureAdapterDeadlineWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.DeadlineWriter")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Fast writer succeeds within the deadline
+	// ========================================================================
+
+	var buf bytes.Buffer
+	fast := adapter.NewWriterWithDeadline(&buf, time.Second)
+	tf.RunTest("Fast - Write returns Ok", fast.Write(ctx, "Hello, Alice!").IsOk())
+	tf.AssertEqual("Fast - output", buf.String(), "Hello, Alice!\n")
+
+	// ========================================================================
+	// Test: Slow writer exceeding the deadline returns InfrastructureError
+	// ========================================================================
+
+	slow := &blockingIOWriter{release: make(chan struct{})}
+	writer := adapter.NewWriterWithDeadline(slow, 20*time.Millisecond)
+	timedOut := writer.Write(ctx, "Hello, Alice!")
+	tf.AssertError("Slow - InfrastructureError", timedOut, apperr.InfrastructureError)
+	if timedOut.IsError() {
+		tf.AssertEqual("Slow - message cites timeout",
+			timedOut.ErrorInfo().Message, "write timed out after 20ms")
+	}
+
+	// The abandoned write still holds the sink, so the next write also
+	// times out instead of overlapping it.
+	tf.AssertError("Slow - next write waits and times out",
+		writer.Write(ctx, "Hello, Bob!"), apperr.InfrastructureError)
+
+	close(slow.release)
+	tf.RunTest("Slow - writes resume once the sink drains",
+		writer.Write(ctx, "Hello, Carol!").IsOk())
+	tf.RunTest("Slow - timed-out write never reached the sink",
+		!strings.Contains(slow.buf.String(), "Bob"))
+	tf.RunTest("Slow - later write reached the sink",
+		strings.HasSuffix(slow.buf.String(), "Hello, Carol!\n"))
+
+	// ========================================================================
+	// Test: Caller cancellation is reported as cancellation, not timeout
+	// ========================================================================
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	result := adapter.NewWriterWithDeadline(&buf, time.Second).Write(cancelled, "Hello, Alice!")
+	tf.AssertError("Cancelled - InfrastructureError", result, apperr.InfrastructureError)
+	if result.IsError() {
+		tf.AssertEqual("Cancelled - message",
+			result.ErrorInfo().Message, "write cancelled: context canceled")
+	}
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Writer decorator that drops consecutive duplicate messages
+
+package adapter
+
+import (
+	"context"
+	"sync"
+
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	"github.com/abitofhelp/hybrid_app_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+// DedupWriter is a decorator that suppresses a message identical to the
+// immediately preceding one, like uniq, for noisy batch input.
+//
+// Only successful writes count as "preceding": after a failed write the
+// same message is attempted again. The comparison and the delegated write
+// happen under one lock, so the writer is safe for concurrent use.
+//
+// Implements: outbound.WriterPort
+type DedupWriter[W outbound.WriterPort] struct {
+	mu    sync.Mutex
+	inner W
+	last  string
+	seen  bool
+}
+
+// NewDedupWriter wraps w so consecutive duplicate messages are written once.
+//
+// Usage:
+//
+//	writer := adapter.NewDedupWriter(adapter.NewConsoleWriter())
+//	writer.Write(ctx, "Hello, Alice!") // stdout: Hello, Alice!
+//	writer.Write(ctx, "Hello, Alice!") // suppressed
+//	writer.Write(ctx, "Hello, Bob!")   // stdout: Hello, Bob!
+func NewDedupWriter[W outbound.WriterPort](w W) *DedupWriter[W] {
+	return &DedupWriter[W]{inner: w}
+}
+
+// Write delegates message unless it repeats the last successful write.
+//
+// Contract:
+//   - Post: Returns Ok(Unit) without calling the wrapped writer if message
+//     equals the previous successfully written message
+//   - Post: Otherwise the wrapped writer's Result is returned unchanged
+func (dw *DedupWriter[W]) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	dw.mu.Lock()
+	defer dw.mu.Unlock()
+
+	if dw.seen && message == dw.last {
+		return domerr.Ok(model.UnitValue)
+	}
+	result := dw.inner.Write(ctx, message)
+	if result.IsOk() {
+		dw.last, dw.seen = message, true
+	}
+	return result
+}
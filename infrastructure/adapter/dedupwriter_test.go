@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/infrastructure/adapter"
+)
+
+// TestInfrastructureAdapterDedupWriter tests the consecutive-duplicate filter.
+func TestInfrastructureAdapterDedupWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.DedupWriter")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Consecutive duplicates are written once
+	// ========================================================================
+
+	inner := &recordingWriter{}
+	writer := adapter.NewDedupWriter(inner)
+	for _, message := range []string{"Hello, Alice!", "Hello, Alice!", "Hello, Alice!", "Hello, Bob!"} {
+		tf.RunTest("Consecutive - Ok", writer.Write(ctx, message).IsOk())
+	}
+	tf.AssertEqual("Consecutive - collapsed", inner.messages,
+		[]string{"Hello, Alice!", "Hello, Bob!"})
+
+	// ========================================================================
+	// Test: Non-consecutive duplicates are both written
+	// ========================================================================
+
+	inner = &recordingWriter{}
+	writer = adapter.NewDedupWriter(inner)
+	for _, message := range []string{"Hello, Alice!", "Hello, Bob!", "Hello, Alice!"} {
+		writer.Write(ctx, message)
+	}
+	tf.AssertEqual("Non-consecutive - all written", inner.messages,
+		[]string{"Hello, Alice!", "Hello, Bob!", "Hello, Alice!"})
+
+	inner = &recordingWriter{}
+	adapter.NewDedupWriter(inner).Write(ctx, "")
+	tf.AssertEqual("First empty message - written", inner.messages, []string{""})
+
+	// ========================================================================
+	// Test: Errors propagate and failed writes are retried
+	// ========================================================================
+
+	failing := adapter.NewDedupWriter(&failingWriter{})
+	tf.AssertError("Failure - Err", failing.Write(ctx, "Hi"), apperr.InfrastructureError)
+	tf.AssertError("Failure - repeat not suppressed", failing.Write(ctx, "Hi"), apperr.InfrastructureError)
+
+	flaky := &flakyWriter{failEvery: 1}
+	retried := adapter.NewDedupWriter(flaky)
+	retried.Write(ctx, "Hi")
+	retried.Write(ctx, "Hi")
+	tf.AssertEqual("Failure - each attempt delegated", flaky.calls, 2)
+
+	// ========================================================================
+	// Test: Concurrent writes of one message - written once
+	// ========================================================================
+
+	inner = &recordingWriter{}
+	shared := adapter.NewDedupWriter(inner)
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 25; i++ {
+				shared.Write(ctx, "Hello, Alice!")
+			}
+		}()
+	}
+	wg.Wait()
+	tf.AssertEqual("Concurrent - single write", inner.messages, []string{"Hello, Alice!"})
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
@@ -0,0 +1,56 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter_test
+
+import (
+	"context"
+	"time"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+// recordingWriter is a WriterPort test double that records every message.
+type recordingWriter struct {
+	messages []string
+}
+
+func (w *recordingWriter) Write(_ context.Context, message string) domerr.Result[model.Unit] {
+	w.messages = append(w.messages, message)
+	return domerr.Ok(model.UnitValue)
+}
+
+// failingWriter is a WriterPort test double that always fails.
+type failingWriter struct {
+	calls int
+}
+
+func (w *failingWriter) Write(_ context.Context, _ string) domerr.Result[model.Unit] {
+	w.calls++
+	return domerr.Err[model.Unit](apperr.NewInfrastructureError("sink unavailable"))
+}
+
+// fixedClock is a ClockPort test double that always returns the same instant.
+type fixedClock struct {
+	at time.Time
+}
+
+func (c fixedClock) Now() time.Time {
+	return c.at
+}
+
+// flakyWriter is a WriterPort test double that fails every failEvery-th call.
+type flakyWriter struct {
+	failEvery int
+	calls     int
+}
+
+func (w *flakyWriter) Write(_ context.Context, _ string) domerr.Result[model.Unit] {
+	w.calls++
+	if w.calls%w.failEvery == 0 {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError("flaky sink"))
+	}
+	return domerr.Ok(model.UnitValue)
+}
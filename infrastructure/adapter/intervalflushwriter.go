@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Writer adapter that buffers output and flushes periodically
+
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+// NewIntervalFlushWriter returns a writer that buffers messages (each
+// followed by a newline, as ConsoleWriter does) and flushes them to w
+// every interval, plus a close function that stops the background
+// flusher and flushes whatever is left.
+//
+// For long-running servers this turns many small writes into one write
+// per interval. A non-positive interval disables the ticker: output is
+// flushed only by close.
+//
+// Usage:
+//
+//	writer, closeWriter := adapter.NewIntervalFlushWriter(os.Stdout, time.Second)
+//	defer closeWriter()
+//	uc := usecase.NewGreetUseCase(writer)
+//
+// Contract:
+//   - Post: Write returns Ok(Unit) once the message is buffered; flushing
+//     happens later, so I/O errors are not seen by Write
+//   - Post: Write returns Err(InfrastructureError) and buffers nothing if
+//     ctx is cancelled or close has been called
+//   - Post: close stops the flusher goroutine before returning, flushes the
+//     remaining buffer, and returns any flush error seen since the writer
+//     was created; calling it again returns nil
+func NewIntervalFlushWriter(w io.Writer, interval time.Duration) (WriterFunc, func() error) {
+	if interval <= 0 {
+		return newIntervalFlushWriter(w, nil, func() {})
+	}
+	ticker := time.NewTicker(interval)
+	return newIntervalFlushWriter(w, ticker.C, ticker.Stop)
+}
+
+// newIntervalFlushWriter is NewIntervalFlushWriter driven by tick: every
+// receive flushes the buffer. A nil tick never fires. stopTicker is called
+// when the flusher goroutine exits.
+func newIntervalFlushWriter(w io.Writer, tick <-chan time.Time, stopTicker func()) (WriterFunc, func() error) {
+	var (
+		mu       sync.Mutex
+		buf      bytes.Buffer
+		closed   bool
+		flushErr error
+		stop     = make(chan struct{})
+		done     = make(chan struct{})
+	)
+
+	// flush writes the buffer out; the caller holds mu.
+	flush := func() {
+		if buf.Len() == 0 {
+			return
+		}
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			flushErr = errors.Join(flushErr, err)
+		}
+		buf.Reset()
+	}
+
+	go func() {
+		defer close(done)
+		defer stopTicker()
+		for {
+			select {
+			case <-tick:
+				mu.Lock()
+				flush()
+				mu.Unlock()
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	write := func(ctx context.Context, message string) domerr.Result[model.Unit] {
+		if ctx.Err() != nil {
+			return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				withRequestID(ctx, fmt.Sprintf("write cancelled: %v", ctx.Err()))))
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if closed {
+			return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				withRequestID(ctx, "write failed: writer closed")))
+		}
+		buf.WriteString(message)
+		buf.WriteByte('\n')
+		return domerr.Ok(model.UnitValue)
+	}
+
+	var once sync.Once
+	closeWriter := func() error {
+		var err error
+		once.Do(func() {
+			close(stop)
+			<-done
+
+			mu.Lock()
+			defer mu.Unlock()
+			closed = true
+			flush()
+			err = flushErr
+		})
+		return err
+	}
+
+	return write, closeWriter
+}
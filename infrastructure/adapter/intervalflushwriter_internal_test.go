@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+)
+
+// countingBuffer is an io.Writer that counts writes (safe to read while
+// the flusher goroutine writes).
+type countingBuffer struct {
+	mu     sync.Mutex
+	buf    bytes.Buffer
+	writes int
+}
+
+func (b *countingBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.writes++
+	return b.buf.Write(p)
+}
+
+func (b *countingBuffer) snapshot() (string, int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String(), b.writes
+}
+
+// TestInfrastructureAdapterIntervalFlushWriterTicks drives the flusher
+// with a manual ticker, so batching is checked without timing.
+func TestInfrastructureAdapterIntervalFlushWriterTicks(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.IntervalFlushWriter.Ticks")
+	ctx := context.Background()
+
+	// tick is unbuffered: a send returns once the flusher has received it,
+	// and the next send returns only after that flush has finished
+	tick := make(chan time.Time)
+	stopped := make(chan struct{})
+	out := &countingBuffer{}
+	writer, closeWriter := newIntervalFlushWriter(out, tick, func() { close(stopped) })
+
+	// ========================================================================
+	// Test: Writes between ticks are flushed as one batch
+	// ========================================================================
+
+	writer.Write(ctx, "Hello, Alice!")
+	writer.Write(ctx, "Hello, Bob!")
+	s, writes := out.snapshot()
+	tf.AssertEqual("Before tick - nothing flushed", s, "")
+	tf.AssertEqual("Before tick - no underlying writes", writes, 0)
+
+	tick <- time.Time{}
+	tick <- time.Time{} // empty buffer: no write
+	s, writes = out.snapshot()
+	tf.AssertEqual("Tick - batch flushed", s, "Hello, Alice!\nHello, Bob!\n")
+	tf.AssertEqual("Tick - one underlying write for the batch", writes, 1)
+
+	// ========================================================================
+	// Test: Later writes go out on the next tick
+	// ========================================================================
+
+	writer.Write(ctx, "Hello, Carol!")
+	tick <- time.Time{}
+	tick <- time.Time{}
+	s, writes = out.snapshot()
+	tf.AssertEqual("Next tick - appended", s, "Hello, Alice!\nHello, Bob!\nHello, Carol!\n")
+	tf.AssertEqual("Next tick - one more underlying write", writes, 2)
+
+	// ========================================================================
+	// Test: Close stops the ticker
+	// ========================================================================
+
+	tf.RunTest("Close - Ok", closeWriter() == nil)
+	select {
+	case <-stopped:
+		tf.RunTest("Close - ticker stopped", true)
+	default:
+		tf.RunTest("Close - ticker stopped", false)
+	}
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
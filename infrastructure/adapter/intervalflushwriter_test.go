@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter_test
+
+import (
+	"bytes"
+	"context"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/infrastructure/adapter"
+)
+
+// syncBuffer is an io.Writer safe to read while a flusher goroutine writes.
+type syncBuffer struct {
+	mu     sync.Mutex
+	buf    bytes.Buffer
+	writes int
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.writes++
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) snapshot() (string, int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String(), b.writes
+}
+
+// eventually polls cond until it holds or a second passes.
+func eventually(cond func() bool) bool {
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if cond() {
+			return true
+		}
+		time.Sleep(time.Millisecond)
+	}
+	return cond()
+}
+
+// TestInfrastructureAdapterIntervalFlushWriter tests the buffering writer.
+func TestInfrastructureAdapterIntervalFlushWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.IntervalFlushWriter")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: The ticker flushes without close
+	// ========================================================================
+
+	// A tick may land between the two writes, so allow up to two
+	// underlying writes; exact batching is covered with a manual ticker
+	// in the internal test
+	out := &syncBuffer{}
+	writer, closeWriter := adapter.NewIntervalFlushWriter(out, 10*time.Millisecond)
+	tf.RunTest("Tick - Write returns Ok", writer.Write(ctx, "Hello, Alice!").IsOk())
+	writer.Write(ctx, "Hello, Bob!")
+	tf.RunTest("Tick - flushed without close", eventually(func() bool {
+		s, _ := out.snapshot()
+		return s == "Hello, Alice!\nHello, Bob!\n"
+	}))
+	_, writes := out.snapshot()
+	tf.RunTest("Tick - at most one underlying write per message", writes >= 1 && writes <= 2)
+	tf.RunTest("Tick - close Ok", closeWriter() == nil)
+
+	// ========================================================================
+	// Test: Flush on close
+	// ========================================================================
+
+	out = &syncBuffer{}
+	writer, closeWriter = adapter.NewIntervalFlushWriter(out, time.Hour)
+	writer.Write(ctx, "Hello, Carol!")
+	s, _ := out.snapshot()
+	tf.AssertEqual("Close - nothing before close", s, "")
+	tf.RunTest("Close - returns nil", closeWriter() == nil)
+	s, _ = out.snapshot()
+	tf.AssertEqual("Close - remaining buffer flushed", s, "Hello, Carol!\n")
+	tf.RunTest("Close - second call returns nil", closeWriter() == nil)
+	tf.AssertError("Close - Write after close fails",
+		writer.Write(ctx, "late"), apperr.InfrastructureError)
+
+	// ========================================================================
+	// Test: Context and flush errors
+	// ========================================================================
+
+	out = &syncBuffer{}
+	writer, closeWriter = adapter.NewIntervalFlushWriter(out, 0)
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	tf.AssertError("Cancelled - InfrastructureError",
+		writer.Write(cancelled, "Hello, Dan!"), apperr.InfrastructureError)
+	closeWriter()
+	s, _ = out.snapshot()
+	tf.AssertEqual("Cancelled - nothing buffered", s, "")
+
+	writer, closeWriter = adapter.NewIntervalFlushWriter(errIOWriter{}, 0)
+	writer.Write(ctx, "Hello, Eve!")
+	err := closeWriter()
+	tf.RunTest("Flush error - returned by close", err != nil && err.Error() == "broken pipe")
+
+	// ========================================================================
+	// Test: Close stops the flusher goroutine
+	// ========================================================================
+
+	before := runtime.NumGoroutine()
+	closers := make([]func() error, 10)
+	for i := range closers {
+		_, closers[i] = adapter.NewIntervalFlushWriter(&syncBuffer{}, time.Millisecond)
+	}
+	tf.RunTest("Leak - goroutines started", runtime.NumGoroutine() >= before+len(closers))
+	for _, c := range closers {
+		c()
+	}
+	tf.RunTest("Leak - goroutines stopped by close", eventually(func() bool {
+		return runtime.NumGoroutine() <= before
+	}))
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Writer decorator that rejects oversized messages
+
+package adapter
+
+import (
+	"context"
+	"fmt"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	"github.com/abitofhelp/hybrid_app_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+// LimitedWriter is a decorator that refuses messages longer than maxBytes,
+// protecting downstream sinks from unexpectedly large output.
+//
+// Error Kind:
+//   - Oversized messages are reported as ValidationError, not
+//     InfrastructureError: the sink is healthy, the content is what's wrong,
+//     and it is derived from user input (e.g., a long name after template
+//     expansion). The CLI therefore exits with the invalid-input code.
+//
+// Implements: outbound.WriterPort
+type LimitedWriter[W outbound.WriterPort] struct {
+	inner    W
+	maxBytes int
+}
+
+// NewLimitedWriter wraps w so messages over maxBytes (UTF-8 bytes, not
+// runes) are rejected.
+//
+// Usage:
+//
+//	writer := adapter.NewLimitedWriter(adapter.NewConsoleWriter(), 4096)
+func NewLimitedWriter[W outbound.WriterPort](w W, maxBytes int) *LimitedWriter[W] {
+	return &LimitedWriter[W]{inner: w, maxBytes: maxBytes}
+}
+
+// Write delegates if message fits within the limit.
+//
+// Contract:
+//   - Post: len(message) <= maxBytes: the wrapped writer's Result is returned
+//   - Post: len(message) > maxBytes: returns Err(ValidationError) and the
+//     wrapped writer is not called
+func (lw *LimitedWriter[W]) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	if len(message) > lw.maxBytes {
+		return domerr.Err[model.Unit](apperr.NewValidationError(fmt.Sprintf(
+			"message of %d bytes exceeds limit of %d bytes", len(message), lw.maxBytes)))
+	}
+	return lw.inner.Write(ctx, message)
+}
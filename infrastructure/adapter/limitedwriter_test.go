@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/infrastructure/adapter"
+)
+
+// TestInfrastructureAdapterLimitedWriter tests the size-limiting decorator.
+func TestInfrastructureAdapterLimitedWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.LimitedWriter")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Boundary - exactly maxBytes is accepted
+	// ========================================================================
+
+	inner := &recordingWriter{}
+	writer := adapter.NewLimitedWriter(inner, 10)
+	tf.RunTest("At limit - Write returns Ok", writer.Write(ctx, strings.Repeat("x", 10)).IsOk())
+	tf.AssertEqual("At limit - inner called", len(inner.messages), 1)
+
+	// ========================================================================
+	// Test: Boundary - maxBytes+1 is rejected without calling inner
+	// ========================================================================
+
+	inner = &recordingWriter{}
+	writer = adapter.NewLimitedWriter(inner, 10)
+	rejected := writer.Write(ctx, strings.Repeat("x", 11))
+	tf.AssertError("Over limit - ValidationError", rejected, apperr.ValidationError)
+	if rejected.IsError() {
+		tf.AssertEqual("Over limit - message",
+			rejected.ErrorInfo().Message, "message of 11 bytes exceeds limit of 10 bytes")
+	}
+	tf.AssertEqual("Over limit - inner not called", len(inner.messages), 0)
+
+	// ========================================================================
+	// Test: Limit counts bytes, not runes
+	// ========================================================================
+
+	inner = &recordingWriter{}
+	tf.AssertError("Multibyte - 3 runes / 6 bytes rejected at 5",
+		adapter.NewLimitedWriter(inner, 5).Write(ctx, "ééé"), apperr.ValidationError)
+
+	// ========================================================================
+	// Test: Inner failure propagates unchanged
+	// ========================================================================
+
+	failing := &failingWriter{}
+	failed := adapter.NewLimitedWriter(failing, 100).Write(ctx, "Hello")
+	tf.AssertError("Inner failure - InfrastructureError", failed, apperr.InfrastructureError)
+	tf.AssertEqual("Inner failure - inner called once", failing.calls, 1)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Line reader adapter over an io.Reader
+
+package adapter
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/valueobject"
+)
+
+// LineReader is an infrastructure adapter that reads newline-delimited
+// input from any io.Reader (stdin, a file, a strings.Reader in tests).
+//
+// Lines are returned without their terminator; "\r\n" endings are accepted.
+//
+// Implements: outbound.ReaderPort
+type LineReader struct {
+	scanner *bufio.Scanner
+	done    bool
+}
+
+// NewLineReader creates a LineReader over r.
+func NewLineReader(r io.Reader) *LineReader {
+	return &LineReader{scanner: bufio.NewScanner(r)}
+}
+
+// NewStdinReader creates a LineReader over os.Stdin.
+func NewStdinReader() *LineReader {
+	return NewLineReader(os.Stdin)
+}
+
+// ReaderFunc adapts an ordinary function to the ReaderPort, as WriterFunc
+// does for the WriterPort.
+//
+// Implements: outbound.ReaderPort
+type ReaderFunc func(ctx context.Context) domerr.Result[valueobject.Option[string]]
+
+// ReadLine calls f(ctx).
+func (f ReaderFunc) ReadLine(ctx context.Context) domerr.Result[valueobject.Option[string]] {
+	return f(ctx)
+}
+
+// NewLimitedLineReader returns a reader over r, like LineReader, that
+// rejects any line longer than maxLineBytes instead of the default 64 KiB.
+// Use it for interactive input, where one pasted blob should fail fast
+// rather than be buffered. Values below 1 keep the default.
+//
+// Contract:
+//   - Same as LineReader.ReadLine
+//   - Post: A line over maxLineBytes returns Err(InfrastructureError) and
+//     ends the input (the underlying scanner cannot resume)
+func NewLimitedLineReader(r io.Reader, maxLineBytes int) ReaderFunc {
+	lr := NewLineReader(r)
+	if maxLineBytes >= 1 {
+		lr.scanner.Buffer(make([]byte, 0, min(maxLineBytes, 4096)), maxLineBytes)
+	}
+	return lr.ReadLine
+}
+
+// ReadLine returns the next line, or None at end of input.
+//
+// Contract:
+//   - Post: Returns Ok(Some(line)) for each line, Ok(None) at EOF (sticky)
+//   - Post: Returns Err(InfrastructureError) if ctx is cancelled or the
+//     underlying reader fails (including a line longer than 64 KiB)
+func (lr *LineReader) ReadLine(ctx context.Context) domerr.Result[valueobject.Option[string]] {
+	if ctx.Err() != nil {
+		return domerr.Err[valueobject.Option[string]](apperr.NewInfrastructureError(
+			withRequestID(ctx, fmt.Sprintf("read cancelled: %v", ctx.Err()))))
+	}
+	if lr.done {
+		return domerr.Ok(valueobject.None[string]())
+	}
+	if lr.scanner.Scan() {
+		return domerr.Ok(valueobject.Some(lr.scanner.Text()))
+	}
+	lr.done = true
+	if err := lr.scanner.Err(); err != nil {
+		return domerr.Err[valueobject.Option[string]](apperr.NewInfrastructureError(
+			withRequestID(ctx, fmt.Sprintf("read failed: %v", err))))
+	}
+	return domerr.Ok(valueobject.None[string]())
+}
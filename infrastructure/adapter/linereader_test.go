@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/infrastructure/adapter"
+)
+
+// TestInfrastructureAdapterLineReader tests the line reader adapter.
+func TestInfrastructureAdapterLineReader(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.LineReader")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Lines are returned in order, then None (sticky)
+	// ========================================================================
+
+	reader := adapter.NewLineReader(strings.NewReader("Alice\r\n\nBob"))
+	var lines []string
+	for {
+		r := reader.ReadLine(ctx)
+		if r.IsError() || r.Value().IsNone() {
+			tf.RunTest("Read - ends with Ok(None)", r.IsOk())
+			break
+		}
+		lines = append(lines, r.Value().Value())
+	}
+	tf.AssertEqual("Read - lines without terminators", lines, []string{"Alice", "", "Bob"})
+	tf.RunTest("Read - EOF is sticky", reader.ReadLine(ctx).Value().IsNone())
+
+	// ========================================================================
+	// Test: Underlying reader failure
+	// ========================================================================
+
+	long := adapter.NewLineReader(strings.NewReader(strings.Repeat("x", 70*1024)))
+	tf.AssertError("Too long - InfrastructureError", long.ReadLine(ctx), apperr.InfrastructureError)
+
+	// ========================================================================
+	// Test: Cancelled context
+	// ========================================================================
+
+	cctx, cancel := context.WithCancel(ctx)
+	cancel()
+	cancelled := adapter.NewLineReader(strings.NewReader("Alice")).ReadLine(cctx)
+	tf.AssertError("Cancelled - InfrastructureError", cancelled, apperr.InfrastructureError)
+
+	// ========================================================================
+	// Test: Limited reader - lines within the limit, then None
+	// ========================================================================
+
+	limited := adapter.NewLimitedLineReader(strings.NewReader("Alice\nBob\n"), 8)
+	tf.AssertEqual("Limited - first line", limited.ReadLine(ctx).Value().UnwrapOr(""), "Alice")
+	tf.AssertEqual("Limited - second line", limited.ReadLine(ctx).Value().UnwrapOr(""), "Bob")
+	tf.RunTest("Limited - EOF", limited.ReadLine(ctx).Value().IsNone())
+
+	// ========================================================================
+	// Test: Limited reader - a line over the limit fails and ends input
+	// ========================================================================
+
+	limited = adapter.NewLimitedLineReader(strings.NewReader("Alice\n"+strings.Repeat("x", 32)+"\nBob\n"), 8)
+	tf.RunTest("Over limit - earlier line Ok", limited.ReadLine(ctx).IsOk())
+	tf.AssertError("Over limit - InfrastructureError", limited.ReadLine(ctx), apperr.InfrastructureError)
+	tf.RunTest("Over limit - input ended", limited.ReadLine(ctx).Value().IsNone())
+
+	// ========================================================================
+	// Test: Limited reader - cancelled context, non-positive limit
+	// ========================================================================
+
+	tf.AssertError("Limited cancelled - InfrastructureError",
+		adapter.NewLimitedLineReader(strings.NewReader("Alice"), 8).ReadLine(cctx), apperr.InfrastructureError)
+	unlimited := adapter.NewLimitedLineReader(strings.NewReader(strings.Repeat("x", 1024)), 0)
+	tf.AssertEqual("Zero limit - default applies", len(unlimited.ReadLine(ctx).Value().UnwrapOr("")), 1024)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Logger adapters (in-memory sink and logging writer decorator)
+
+package adapter
+
+import (
+	"context"
+	"sync"
+
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	"github.com/abitofhelp/hybrid_app_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+// InMemoryLogger is a LoggerPort that keeps every entry in order.
+//
+// Intended for tests and local diagnostics; safe for concurrent use.
+//
+// Implements: outbound.LoggerPort
+type InMemoryLogger struct {
+	mu      sync.Mutex
+	entries []outbound.LogEntry
+}
+
+// NewInMemoryLogger creates an empty log sink.
+func NewInMemoryLogger() *InMemoryLogger {
+	return &InMemoryLogger{}
+}
+
+// Log appends entry.
+func (l *InMemoryLogger) Log(entry outbound.LogEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.entries = append(l.entries, entry)
+}
+
+// Entries returns a copy of the entries logged so far.
+func (l *InMemoryLogger) Entries() []outbound.LogEntry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]outbound.LogEntry(nil), l.entries...)
+}
+
+// LoggingWriter is a decorator that logs failed writes, tagged with the
+// infrastructure layer and the request ID from the write's context.
+//
+// Implements: outbound.WriterPort
+type LoggingWriter[W outbound.WriterPort] struct {
+	inner W
+	log   outbound.LoggerFunc
+}
+
+// NewLoggingWriter wraps w so write failures are reported to sink.
+//
+// Usage:
+//
+//	sink := adapter.NewInMemoryLogger()
+//	writer := adapter.NewLoggingWriter(adapter.NewConsoleWriter(), sink)
+//	uc := usecase.NewGreetUseCase(writer, usecase.WithLogger(sink))
+func NewLoggingWriter[W outbound.WriterPort](w W, sink outbound.LoggerPort) *LoggingWriter[W] {
+	return &LoggingWriter[W]{inner: w, log: outbound.LogWith(sink, outbound.LayerInfrastructure)}
+}
+
+// Write delegates to the wrapped writer and logs the error, if any.
+//
+// Contract:
+//   - Post: The wrapped writer's Result is returned unchanged
+//   - Post: Exactly one entry is logged per failed write, none on success
+func (lw *LoggingWriter[W]) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	return lw.inner.Write(ctx, message).InspectErr(func(e domerr.ErrorType) {
+		lw.log(ctx, e.Message)
+	})
+}
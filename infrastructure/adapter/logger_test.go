@@ -0,0 +1,58 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter_test
+
+import (
+	"context"
+	"testing"
+
+	appctx "github.com/abitofhelp/hybrid_app_go/application/context"
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/application/port/outbound"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/infrastructure/adapter"
+)
+
+// TestInfrastructureAdapterLogger tests layer- and request-tagged logging.
+func TestInfrastructureAdapterLogger(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.Logger")
+	ctx := appctx.WithRequestID(context.Background(), "req-42")
+
+	// ========================================================================
+	// Test: LogWith tags entries with layer and request ID
+	// ========================================================================
+
+	sink := adapter.NewInMemoryLogger()
+	outbound.LogWith(sink, outbound.LayerApplication)(ctx, "hello")
+	outbound.LogWith(sink, outbound.LayerInfrastructure)(context.Background(), "no id")
+	tf.AssertEqual("LogWith - entries", sink.Entries(), []outbound.LogEntry{
+		{Layer: "application", RequestID: "req-42", Message: "hello"},
+		{Layer: "infrastructure", RequestID: "", Message: "no id"},
+	})
+
+	// ========================================================================
+	// Test: LoggingWriter logs write failures at the infrastructure layer
+	// ========================================================================
+
+	sink = adapter.NewInMemoryLogger()
+	result := adapter.NewLoggingWriter(&failingWriter{}, sink).Write(ctx, "Hello, Alice!")
+	tf.AssertError("Write failure - InfrastructureError", result, apperr.InfrastructureError)
+	tf.AssertEqual("Write failure - entries", sink.Entries(), []outbound.LogEntry{
+		{Layer: "infrastructure", RequestID: "req-42", Message: "sink unavailable"},
+	})
+
+	// ========================================================================
+	// Test: LoggingWriter passes successful writes through silently
+	// ========================================================================
+
+	sink = adapter.NewInMemoryLogger()
+	inner := &recordingWriter{}
+	result = adapter.NewLoggingWriter(inner, sink).Write(ctx, "Hello, Alice!")
+	tf.RunTest("Success - IsOk", result.IsOk())
+	tf.AssertEqual("Success - forwarded", inner.messages, []string{"Hello, Alice!"})
+	tf.AssertEqual("Success - no entries", len(sink.Entries()), 0)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Metrics adapters (in-memory registry and backend bridge)
+
+package adapter
+
+import "sync"
+
+// MetricsFunc adapts an ordinary function to the MetricsPort, in the style
+// of http.HandlerFunc. It is the thin shape for real backends:
+//
+//	counter := promauto.NewCounterVec(...)
+//	metrics := adapter.MetricsFunc(func(name string, tags map[string]string) {
+//	    counter.WithLabelValues(name).Inc()
+//	})
+//
+// Implements: outbound.MetricsPort
+type MetricsFunc func(name string, tags map[string]string)
+
+// IncrementCounter calls f(name, tags).
+func (f MetricsFunc) IncrementCounter(name string, tags map[string]string) {
+	f(name, tags)
+}
+
+// InMemoryMetrics is a MetricsPort that keeps counters in a map, keyed by
+// counter name. Tags are accepted but not part of the key.
+//
+// Intended for tests and local diagnostics; safe for concurrent use.
+//
+// Implements: outbound.MetricsPort
+type InMemoryMetrics struct {
+	mu       sync.Mutex
+	counters map[string]int
+}
+
+// NewInMemoryMetrics creates an empty counter registry.
+func NewInMemoryMetrics() *InMemoryMetrics {
+	return &InMemoryMetrics{counters: make(map[string]int)}
+}
+
+// IncrementCounter adds 1 to the counter called name.
+func (m *InMemoryMetrics) IncrementCounter(name string, _ map[string]string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.counters[name]++
+}
+
+// Count returns the current value of the counter called name (0 if unseen).
+func (m *InMemoryMetrics) Count(name string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.counters[name]
+}
+
+// Snapshot returns a copy of all counters.
+func (m *InMemoryMetrics) Snapshot() map[string]int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	out := make(map[string]int, len(m.counters))
+	for name, n := range m.counters {
+		out[name] = n
+	}
+	return out
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter_test
+
+import (
+	"testing"
+
+	"github.com/abitofhelp/hybrid_app_go/application/port/outbound"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/infrastructure/adapter"
+)
+
+// Compile-time checks: both adapters satisfy the MetricsPort contract.
+var (
+	_ outbound.MetricsPort = (*adapter.InMemoryMetrics)(nil)
+	_ outbound.MetricsPort = adapter.MetricsFunc(nil)
+)
+
+// TestInfrastructureAdapterMetrics tests the metrics adapters.
+func TestInfrastructureAdapterMetrics(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.Metrics")
+
+	// ========================================================================
+	// Test: InMemoryMetrics counts by name
+	// ========================================================================
+
+	metrics := adapter.NewInMemoryMetrics()
+	tf.AssertEqual("Registry - unseen counter is 0", metrics.Count("x"), 0)
+	metrics.IncrementCounter("x", nil)
+	metrics.IncrementCounter("x", map[string]string{"k": "v"})
+	metrics.IncrementCounter("y", nil)
+	tf.AssertEqual("Registry - snapshot", metrics.Snapshot(), map[string]int{"x": 2, "y": 1})
+
+	// ========================================================================
+	// Test: MetricsFunc forwards name and tags
+	// ========================================================================
+
+	var gotName string
+	var gotTags map[string]string
+	fn := adapter.MetricsFunc(func(name string, tags map[string]string) {
+		gotName, gotTags = name, tags
+	})
+	fn.IncrementCounter("greet.success", map[string]string{"env": "test"})
+	tf.AssertEqual("Func - name forwarded", gotName, "greet.success")
+	tf.AssertEqual("Func - tags forwarded", gotTags, map[string]string{"env": "test"})
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
@@ -0,0 +1,67 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Writer that fans each message out to several writers
+
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	"github.com/abitofhelp/hybrid_app_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+// NewMultiWriter returns a writer that sends every message to each of
+// writers, like io.MultiWriter, but without stopping at the first failure.
+//
+// The writes run one at a time in registration order, so a writer
+// registered twice is never called concurrently with itself, and failures
+// are reported in that same order. A panicking writer fails only its own
+// write: the panic is converted to an InfrastructureError and the later
+// writers still run.
+//
+// Contract:
+//   - Post: Every writer is called exactly once per message, in order
+//   - Post: Returns Ok(Unit) if every write succeeds (or writers is empty)
+//   - Post: Otherwise returns Err(NewMultiError(...)) holding one error per
+//     failed writer, in registration order, each prefixed "writers[i]: "
+//   - Never panics (a wrapped writer's panic becomes its error)
+//
+// Usage:
+//
+//	writer := adapter.NewMultiWriter(adapter.NewConsoleWriter(), fileWriter)
+//	writer.Write(ctx, "Hello, Alice!") // stdout and file
+func NewMultiWriter(writers ...outbound.WriterPort) WriterFunc {
+	writers = append([]outbound.WriterPort(nil), writers...)
+	return func(ctx context.Context, message string) domerr.Result[model.Unit] {
+		var errs []apperr.ErrorType
+		for i, w := range writers {
+			if result := writeRecovered(ctx, w, message); result.IsError() {
+				err := result.ErrorInfo()
+				err.Message = fmt.Sprintf("writers[%d]: %s", i, err.Message)
+				errs = append(errs, err)
+			}
+		}
+		if len(errs) > 0 {
+			return domerr.Err[model.Unit](apperr.NewMultiError(errs...))
+		}
+		return domerr.Ok(model.UnitValue)
+	}
+}
+
+// writeRecovered calls w.Write, converting a panic into an
+// InfrastructureError with the panicking goroutine's stack attached.
+func writeRecovered(ctx context.Context, w outbound.WriterPort, message string) (result domerr.Result[model.Unit]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				withRequestID(ctx, fmt.Sprintf("write panicked: %v", r))).WithStack(debug.Stack()))
+		}
+	}()
+	return w.Write(ctx, message)
+}
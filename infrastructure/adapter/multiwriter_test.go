@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/infrastructure/adapter"
+)
+
+// failWith returns a writer that always fails with message.
+func failWith(message string) adapter.WriterFunc {
+	return func(_ context.Context, _ string) apperr.Result[model.Unit] {
+		return apperr.Err[model.Unit](apperr.NewInfrastructureError(message))
+	}
+}
+
+// TestInfrastructureAdapterMultiWriter tests the fan-out writer.
+func TestInfrastructureAdapterMultiWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.MultiWriter")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Every writer receives the message
+	// ========================================================================
+
+	first, second := &recordingWriter{}, &recordingWriter{}
+	writer := adapter.NewMultiWriter(first, second)
+	tf.RunTest("Success - Ok", writer.Write(ctx, "Hello, Alice!").IsOk())
+	tf.AssertEqual("Success - first writer", first.messages, []string{"Hello, Alice!"})
+	tf.AssertEqual("Success - second writer", second.messages, []string{"Hello, Alice!"})
+	tf.RunTest("Empty - Ok", adapter.NewMultiWriter().Write(ctx, "Hi").IsOk())
+
+	// ========================================================================
+	// Test: Failures do not stop the fan-out
+	// ========================================================================
+
+	failing, after := &failingWriter{}, &recordingWriter{}
+	partial := adapter.NewMultiWriter(failing, after).Write(ctx, "Hi")
+	tf.AssertError("Partial - Err", partial, apperr.InfrastructureError)
+	tf.AssertEqual("Partial - single error unwrapped", partial.ErrorInfo().Message, "writers[0]: sink unavailable")
+	tf.AssertEqual("Partial - later writer still called", after.messages, []string{"Hi"})
+
+	// ========================================================================
+	// Test: Three failures are reported in registration order
+	// ========================================================================
+
+	ordered := adapter.NewMultiWriter(
+		failWith("disk full"),
+		failWith("broken pipe"),
+		failWith("connection refused"),
+	)
+	result := ordered.Write(ctx, "Hi")
+	tf.AssertError("Ordered - Err", result, apperr.InfrastructureError)
+	tf.AssertEqual("Ordered - registration order", result.ErrorInfo().Message, strings.Join([]string{
+		"3 errors:",
+		"1. InfrastructureError: writers[0]: disk full",
+		"2. InfrastructureError: writers[1]: broken pipe",
+		"3. InfrastructureError: writers[2]: connection refused",
+	}, "\n"))
+
+	// ========================================================================
+	// Test: A panicking writer fails its own slot; the rest still run
+	// ========================================================================
+
+	var order []string
+	record := func(name string) adapter.WriterFunc {
+		return func(_ context.Context, _ string) apperr.Result[model.Unit] {
+			order = append(order, name)
+			return apperr.Ok(model.UnitValue)
+		}
+	}
+	panicking := adapter.WriterFunc(func(_ context.Context, _ string) apperr.Result[model.Unit] {
+		order = append(order, "panicking")
+		panic("boom")
+	})
+	recovered := adapter.NewMultiWriter(record("first"), panicking, record("last")).Write(ctx, "Hi")
+	tf.AssertError("Panic - InfrastructureError", recovered, apperr.InfrastructureError)
+	if recovered.IsError() {
+		tf.AssertEqual("Panic - message names the slot", recovered.ErrorInfo().Message,
+			"writers[1]: write panicked: boom")
+		tf.RunTest("Panic - stack attached", recovered.ErrorInfo().Stack() != "")
+	}
+	tf.AssertEqual("Panic - writers called in order, later writer still runs", order,
+		[]string{"first", "panicking", "last"})
+
+	// ========================================================================
+	// Test: A writer registered twice is called once per slot, in turn
+	// ========================================================================
+
+	shared := &recordingWriter{}
+	tf.RunTest("Duplicate - Ok", adapter.NewMultiWriter(shared, shared).Write(ctx, "Hi").IsOk())
+	tf.AssertEqual("Duplicate - written twice", shared.messages, []string{"Hi", "Hi"})
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
@@ -0,0 +1,80 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Writer adapter that emits newline-delimited JSON events
+
+package adapter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	appctx "github.com/abitofhelp/hybrid_app_go/application/context"
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	"github.com/abitofhelp/hybrid_app_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+// ndjsonEvent is one NDJSON line written by NewNDJSONWriter.
+type ndjsonEvent struct {
+	TS        string `json:"ts"`
+	Message   string `json:"message"`
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// NewNDJSONWriter returns a writer that emits each message to w as one
+// JSON object per line (NDJSON), for ingestion by log shippers:
+//
+//	{"ts":"2025-01-02T03:04:05Z","message":"Hello, Alice!"}
+//
+// ts is clock.Now() in UTC, RFC 3339 with sub-second precision when
+// present; inject a fixed clock for deterministic output. request_id is
+// added when ctx carries one. Quotes, backslashes, and control characters
+// in the message are JSON-escaped; HTML characters (<, >, &) are left as is.
+//
+// Usage:
+//
+//	writer := adapter.NewNDJSONWriter(os.Stdout, adapter.SystemClock{})
+//
+// Contract:
+//   - Post: Each Ok write emits exactly one complete line in one Write call
+//   - Post: Safe for concurrent use; lines are never interleaved
+//   - Post: Returns Err(InfrastructureError) if ctx is cancelled (nothing
+//     written) or w fails
+func NewNDJSONWriter[C outbound.ClockPort](w io.Writer, clock C) WriterFunc {
+	var mu sync.Mutex
+
+	return func(ctx context.Context, message string) domerr.Result[model.Unit] {
+		if ctx.Err() != nil {
+			return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				withRequestID(ctx, fmt.Sprintf("write cancelled: %v", ctx.Err()))))
+		}
+
+		ev := ndjsonEvent{TS: clock.Now().UTC().Format(time.RFC3339Nano), Message: message}
+		if id, ok := appctx.RequestIDFrom(ctx); ok {
+			ev.RequestID = id
+		}
+		var line bytes.Buffer
+		enc := json.NewEncoder(&line)
+		enc.SetEscapeHTML(false)
+		if err := enc.Encode(ev); err != nil {
+			return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				withRequestID(ctx, fmt.Sprintf("encode failed: %v", err))))
+		}
+
+		mu.Lock()
+		_, err := w.Write(line.Bytes())
+		mu.Unlock()
+		if err != nil {
+			return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				withRequestID(ctx, fmt.Sprintf("write failed: %v", err))))
+		}
+		return domerr.Ok(model.UnitValue)
+	}
+}
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	appctx "github.com/abitofhelp/hybrid_app_go/application/context"
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/infrastructure/adapter"
+)
+
+// TestInfrastructureAdapterNDJSONWriter tests the NDJSON event adapter.
+func TestInfrastructureAdapterNDJSONWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.NDJSONWriter")
+	ctx := context.Background()
+	clock := fixedClock{at: time.Date(2025, 1, 2, 3, 4, 5, 0, time.FixedZone("EST", -5*60*60))}
+
+	// ========================================================================
+	// Test: One valid JSON object per line with ts and message
+	// ========================================================================
+
+	var buf bytes.Buffer
+	writer := adapter.NewNDJSONWriter(&buf, clock)
+	tf.RunTest("Write - Ok", writer.Write(ctx, "Hello, Alice!").IsOk())
+	writer.Write(ctx, "Hello, Bob!")
+	tf.AssertEqual("Write - exact line", strings.SplitN(buf.String(), "\n", 2)[0],
+		`{"ts":"2025-01-02T08:04:05Z","message":"Hello, Alice!"}`)
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	tf.AssertEqual("Write - one line per message", len(lines), 2)
+	var event struct {
+		TS      string `json:"ts"`
+		Message string `json:"message"`
+	}
+	for _, line := range lines {
+		tf.RunTest("Write - valid JSON", json.Valid([]byte(line)))
+	}
+	tf.RunTest("Decode - Ok", json.Unmarshal([]byte(lines[1]), &event) == nil)
+	tf.AssertEqual("Decode - ts in UTC", event.TS, "2025-01-02T08:04:05Z")
+	tf.AssertEqual("Decode - message", event.Message, "Hello, Bob!")
+
+	// ========================================================================
+	// Test: Quotes and control characters are escaped
+	// ========================================================================
+
+	buf.Reset()
+	writer.Write(ctx, `Hello, "Al\ice"`+"\n<&>!")
+	line := strings.TrimSuffix(buf.String(), "\n")
+	tf.AssertEqual("Escape - single line", strings.Count(buf.String(), "\n"), 1)
+	tf.RunTest("Escape - quotes escaped", strings.Contains(line, `\"Al\\ice\"\n<&>!`))
+	event.Message = ""
+	tf.RunTest("Escape - decodes", json.Unmarshal([]byte(line), &event) == nil)
+	tf.AssertEqual("Escape - round trip", event.Message, `Hello, "Al\ice"`+"\n<&>!")
+
+	// ========================================================================
+	// Test: Request ID is included when present
+	// ========================================================================
+
+	buf.Reset()
+	writer.Write(appctx.WithRequestID(ctx, "req-42"), "Hi")
+	tf.AssertEqual("RequestID - field added", strings.TrimSuffix(buf.String(), "\n"),
+		`{"ts":"2025-01-02T08:04:05Z","message":"Hi","request_id":"req-42"}`)
+
+	// ========================================================================
+	// Test: Cancellation and I/O failures
+	// ========================================================================
+
+	buf.Reset()
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	tf.AssertError("Cancelled - Err", writer.Write(cancelled, "Hi"), apperr.InfrastructureError)
+	tf.AssertEqual("Cancelled - nothing written", buf.Len(), 0)
+
+	broken := adapter.NewNDJSONWriter(errIOWriter{}, clock)
+	result := broken.Write(appctx.WithRequestID(ctx, "req-7"), "Hi")
+	tf.AssertError("Failure - Err", result, apperr.InfrastructureError)
+	tf.AssertEqual("Failure - message", result.ErrorInfo().Message,
+		"write failed: broken pipe [request_id=req-7]")
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
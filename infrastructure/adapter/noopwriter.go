@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Writer adapter that discards every message
+
+package adapter
+
+import (
+	"context"
+	"fmt"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+// NoopWriter is a writer adapter that discards every message.
+//
+// Used for --dry-run (validate without output) and as a ready-made
+// WriterPort in tests that don't care about the written text.
+//
+// Implements: outbound.WriterPort
+type NoopWriter struct{}
+
+// NewNoopWriter creates a writer that accepts messages without side effects.
+func NewNoopWriter() NoopWriter {
+	return NoopWriter{}
+}
+
+// Write discards message.
+//
+// Contract:
+//   - Post: Returns Ok(Unit) unless ctx is cancelled; nothing is written
+//   - Post: Returns Err(InfrastructureError) if ctx is cancelled, matching
+//     the WriterPort contract honoured by ConsoleWriter
+func (NoopWriter) Write(ctx context.Context, _ string) domerr.Result[model.Unit] {
+	if ctx.Err() != nil {
+		return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+			withRequestID(ctx, fmt.Sprintf("write cancelled: %v", ctx.Err()))))
+	}
+	return domerr.Ok(model.UnitValue)
+}
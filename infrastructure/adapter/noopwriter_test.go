@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"testing"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/infrastructure/adapter"
+)
+
+// TestInfrastructureAdapterNoopWriter tests the discarding writer.
+func TestInfrastructureAdapterNoopWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.NoopWriter")
+	writer := adapter.NewNoopWriter()
+
+	// ========================================================================
+	// Test: Returns Ok and writes nothing to stdout
+	// ========================================================================
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe: %v", err)
+	}
+	origStdout := os.Stdout
+	os.Stdout = w
+	result := writer.Write(context.Background(), "Hello, Alice!")
+	os.Stdout = origStdout
+	w.Close()
+	out, _ := io.ReadAll(r)
+
+	tf.RunTest("Default - Write returns Ok", result.IsOk())
+	tf.AssertEqual("Default - nothing written to stdout", string(out), "")
+
+	// ========================================================================
+	// Test: Cancelled context returns InfrastructureError
+	// ========================================================================
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	cancelled := writer.Write(ctx, "Hello, Alice!")
+	tf.AssertError("Cancelled - InfrastructureError", cancelled, apperr.InfrastructureError)
+	if cancelled.IsError() {
+		tf.AssertEqual("Cancelled - message",
+			cancelled.ErrorInfo().Message, "write cancelled: context canceled")
+	}
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
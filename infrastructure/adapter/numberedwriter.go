@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Writer decorator that numbers messages
+
+package adapter
+
+import (
+	"context"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	"github.com/abitofhelp/hybrid_app_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+// NumberedWriter is a decorator that prefixes each message with a 1-based
+// line number, "N: ", for readable batch output.
+//
+// The counter is atomic, so concurrent writers always get distinct numbers.
+// Numbers reach the wrapped writer in order only if calls are serialized;
+// wrap with SynchronizedWriter (outermost) when goroutines share it.
+//
+// Implements: outbound.WriterPort
+type NumberedWriter[W outbound.WriterPort] struct {
+	inner W
+	next  atomic.Int64
+}
+
+// NewNumberedWriter wraps w so every message is numbered, starting at 1.
+//
+// Usage:
+//
+//	writer := adapter.NewSynchronizedWriter(adapter.NewNumberedWriter(adapter.NewConsoleWriter()))
+//	writer.Write(ctx, "Hello, Alice!") // stdout: 1: Hello, Alice!
+//	writer.Write(ctx, "Hello, Bob!")   // stdout: 2: Hello, Bob!
+func NewNumberedWriter[W outbound.WriterPort](w W) *NumberedWriter[W] {
+	return &NumberedWriter[W]{inner: w}
+}
+
+// Write numbers message and delegates.
+//
+// Contract:
+//   - Post: The wrapped writer receives "N: " + message, where N is one
+//     more than the previous call's number (the first call gets 1)
+//   - Post: Every call consumes a number, even if the write fails
+//   - Post: The wrapped writer's Result is returned unchanged
+func (nw *NumberedWriter[W]) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	n := nw.next.Add(1)
+	return nw.inner.Write(ctx, strconv.FormatInt(n, 10)+": "+message)
+}
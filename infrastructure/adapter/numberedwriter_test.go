@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/infrastructure/adapter"
+)
+
+// TestInfrastructureAdapterNumberedWriter tests the line-numbering decorator.
+func TestInfrastructureAdapterNumberedWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.NumberedWriter")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Sequential writes are numbered from 1
+	// ========================================================================
+
+	inner := &recordingWriter{}
+	writer := adapter.NewNumberedWriter(inner)
+	for _, name := range []string{"Alice", "Bob", "Carol"} {
+		writer.Write(ctx, "Hello, "+name+"!")
+	}
+	tf.AssertEqual("Sequential - numbered in order", inner.messages,
+		[]string{"1: Hello, Alice!", "2: Hello, Bob!", "3: Hello, Carol!"})
+
+	// ========================================================================
+	// Test: Failures pass through and still consume a number
+	// ========================================================================
+
+	flaky := &flakyWriter{failEvery: 2}
+	numbered := adapter.NewNumberedWriter(flaky)
+	tf.RunTest("Passthrough - Ok", numbered.Write(ctx, "a").IsOk())
+	tf.AssertError("Passthrough - Err", numbered.Write(ctx, "b"), apperr.InfrastructureError)
+
+	inner = &recordingWriter{}
+	writer = adapter.NewNumberedWriter(inner)
+	adapter.NewNumberedWriter(&failingWriter{}).Write(ctx, "x")
+	writer.Write(ctx, "first")
+	tf.AssertEqual("Independent counters - each starts at 1", inner.messages, []string{"1: first"})
+
+	// ========================================================================
+	// Test: Interleaved via SynchronizedWriter - intact lines, in order
+	// ========================================================================
+
+	const goroutines, perGoroutine = 8, 25
+	sink := &byteWriter{}
+	shared := adapter.NewSynchronizedWriter(adapter.NewNumberedWriter(sink))
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < perGoroutine; i++ {
+				shared.Write(ctx, fmt.Sprintf("Hello, worker-%02d-%02d!", g, i))
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	lines := strings.Split(strings.TrimSuffix(string(sink.out), "\n"), "\n")
+	tf.AssertEqual("Interleaved - line count", len(lines), goroutines*perGoroutine)
+	outOfOrder := 0
+	for i, line := range lines {
+		var n, g, j int
+		if _, err := fmt.Sscanf(line, "%d: Hello, worker-%02d-%02d!", &n, &g, &j); err != nil || n != i+1 {
+			outOfOrder++
+		}
+	}
+	tf.AssertEqual("Interleaved - numbered 1..N in order, lines intact", outOfOrder, 0)
+
+	// ========================================================================
+	// Test: Shared without serialization - numbers still unique
+	// ========================================================================
+
+	inner = &recordingWriter{}
+	unordered := adapter.NewNumberedWriter(adapter.NewSynchronizedWriter(inner))
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perGoroutine; i++ {
+				unordered.Write(ctx, "Hi")
+			}
+		}()
+	}
+	wg.Wait()
+
+	seen := make(map[string]bool, len(inner.messages))
+	for _, message := range inner.messages {
+		seen[message] = true
+	}
+	missing := 0
+	for n := 1; n <= goroutines*perGoroutine; n++ {
+		if !seen[fmt.Sprintf("%d: Hi", n)] {
+			missing++
+		}
+	}
+	tf.AssertEqual("Unserialized - every number used exactly once", missing, 0)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: io.Writer decorator that caps total output across an invocation
+
+package adapter
+
+import (
+	"fmt"
+	"io"
+	"sync"
+)
+
+// OutputBudgetWriter is an io.Writer decorator that caps the cumulative
+// bytes written through it, guarding against runaway output (a huge
+// --count or batch).
+//
+// It sits under the format adapter (NewWriter, NewCSVWriter), so the
+// budget is charged for the encoded byte stream: newlines or CRLF, a BOM,
+// and CSV quoting all count. Unlike LimitedWriter, which judges each
+// message on its own, the budget spans every write. Once a write would
+// exceed the budget, it and every later write fail without reaching the
+// wrapped writer; nothing is written partially.
+type OutputBudgetWriter struct {
+	w        io.Writer
+	maxBytes int64
+	mu       sync.Mutex
+	used     int64
+	exceeded bool
+}
+
+// NewOutputBudgetWriter wraps w so at most maxBytes bytes are written to
+// it in total.
+//
+// Usage:
+//
+//	writer := adapter.NewCSVWriter(adapter.NewOutputBudgetWriter(os.Stdout, 1<<20))
+func NewOutputBudgetWriter(w io.Writer, maxBytes int64) *OutputBudgetWriter {
+	return &OutputBudgetWriter{w: w, maxBytes: maxBytes}
+}
+
+// Write charges p against the budget and delegates if it fits.
+//
+// Contract:
+//   - Post: Within budget: the wrapped writer's result is returned
+//   - Post: Over budget: returns 0 and an "output limit of N bytes
+//     exceeded" error, the wrapped writer is not called, and all later
+//     writes fail the same way
+//   - Post: Bytes are charged when handed to the wrapped writer, even if
+//     that write then fails
+//   - Safe for concurrent use
+func (bw *OutputBudgetWriter) Write(p []byte) (int, error) {
+	bw.mu.Lock()
+	if bw.exceeded || bw.used+int64(len(p)) > bw.maxBytes {
+		bw.exceeded = true
+		bw.mu.Unlock()
+		return 0, fmt.Errorf("output limit of %d bytes exceeded", bw.maxBytes)
+	}
+	bw.used += int64(len(p))
+	bw.mu.Unlock()
+
+	return bw.w.Write(p)
+}
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/infrastructure/adapter"
+)
+
+// TestInfrastructureAdapterOutputBudgetWriter tests the cumulative output cap.
+func TestInfrastructureAdapterOutputBudgetWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.OutputBudgetWriter")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Writes succeed until the budget
+	// ========================================================================
+
+	var buf bytes.Buffer
+	budget := adapter.NewOutputBudgetWriter(&buf, 28)
+	_, err := io.WriteString(budget, "Hello, Alice!\n")
+	tf.RunTest("Within - first write Ok", err == nil)
+	_, err = io.WriteString(budget, "Hello, Alice!\n")
+	tf.RunTest("Within - exactly at budget Ok", err == nil)
+
+	// ========================================================================
+	// Test: The write that would exceed the budget fails, nothing partial
+	// ========================================================================
+
+	n, err := io.WriteString(budget, "x")
+	tf.RunTest("Over - error", err != nil)
+	if err != nil {
+		tf.AssertEqual("Over - message", err.Error(), "output limit of 28 bytes exceeded")
+	}
+	tf.AssertEqual("Over - nothing written", n, 0)
+	tf.AssertEqual("Over - output holds only writes within budget", buf.String(),
+		"Hello, Alice!\nHello, Alice!\n")
+
+	// ========================================================================
+	// Test: Once exceeded, later writes fail even if they would fit
+	// ========================================================================
+
+	buf.Reset()
+	sticky := adapter.NewOutputBudgetWriter(&buf, 10)
+	io.WriteString(sticky, "Hello, Alice!\n")
+	_, err = io.WriteString(sticky, "hi")
+	tf.RunTest("Sticky - small write after overflow fails", err != nil)
+	tf.AssertEqual("Sticky - wrapped writer never called", buf.Len(), 0)
+
+	// ========================================================================
+	// Test: Failed wrapped writes still consume budget; errors propagate
+	// ========================================================================
+
+	failing := adapter.NewOutputBudgetWriter(errIOWriter{}, 4)
+	_, err = io.WriteString(failing, "abc")
+	tf.RunTest("Inner failure - propagated", err != nil && err.Error() == "broken pipe")
+	_, err = io.WriteString(failing, "ab")
+	tf.RunTest("Inner failure - budget consumed", err != nil && err.Error() == "output limit of 4 bytes exceeded")
+
+	// ========================================================================
+	// Test: Encoded bytes are charged - newline under the text adapter
+	// ========================================================================
+
+	buf.Reset()
+	text := adapter.NewWriter(adapter.NewOutputBudgetWriter(&buf, 13))
+	tf.AssertError("Text - 13-byte message plus newline exceeds 13 bytes",
+		text.Write(ctx, "Hello, Alice!"), apperr.InfrastructureError)
+	tf.AssertEqual("Text - nothing written", buf.Len(), 0)
+
+	// ========================================================================
+	// Test: Encoded bytes are charged - CSV quoting under the CSV adapter
+	// ========================================================================
+
+	// "Hello, O'Brien, Jr.!" is 20 bytes (21 with its newline), but the
+	// quoted CSV row is 23 bytes
+	buf.Reset()
+	csv := adapter.NewCSVWriter(adapter.NewOutputBudgetWriter(&buf, 21))
+	quoted := csv.Write(ctx, "Hello, O'Brien, Jr.!")
+	tf.AssertError("CSV - quoting pushes the row past the budget", quoted, apperr.InfrastructureError)
+	if quoted.IsError() {
+		tf.AssertEqual("CSV - message", quoted.ErrorInfo().Message,
+			"write failed: output limit of 21 bytes exceeded")
+	}
+	tf.AssertEqual("CSV - nothing written", buf.Len(), 0)
+
+	buf.Reset()
+	csv = adapter.NewCSVWriter(adapter.NewOutputBudgetWriter(&buf, 23))
+	tf.RunTest("CSV - quoted row exactly at budget Ok", csv.Write(ctx, "Hello, O'Brien, Jr.!").IsOk())
+	tf.AssertEqual("CSV - quoted row written", buf.String(), "\"Hello, O'Brien, Jr.!\"\n")
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Writer decorator that redacts sensitive substrings
+
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	"github.com/abitofhelp/hybrid_app_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+// Redacted replaces every pattern match written through a RedactingWriter.
+const Redacted = "[REDACTED]"
+
+// RedactingWriter is a decorator that replaces every match of its patterns
+// with "[REDACTED]" before delegating to the wrapped writer.
+//
+// Implements: outbound.WriterPort
+type RedactingWriter[W outbound.WriterPort] struct {
+	inner    W
+	patterns []*regexp.Regexp
+}
+
+// NewRedactingWriter wraps w with the given regular expressions (RE2 syntax).
+//
+// Patterns are compiled once, here, so a typo fails at wiring time rather
+// than on the first write.
+//
+// Usage:
+//
+//	result := adapter.NewRedactingWriter(adapter.NewConsoleWriter(), []string{`\d{3}-\d{2}-\d{4}`})
+//	if result.IsOk() {
+//	    writer := result.Value()
+//	}
+//
+// Contract:
+//   - Post: Returns Err(ValidationError) naming the first pattern that is
+//     empty or fails to compile
+func NewRedactingWriter[W outbound.WriterPort](w W, patterns []string) domerr.Result[*RedactingWriter[W]] {
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+	for i, p := range patterns {
+		if p == "" {
+			return domerr.Err[*RedactingWriter[W]](apperr.NewValidationError(
+				fmt.Sprintf("redaction pattern %d is empty", i)))
+		}
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return domerr.Err[*RedactingWriter[W]](apperr.NewValidationError(
+				fmt.Sprintf("invalid redaction pattern %d: %v", i, err)))
+		}
+		compiled = append(compiled, re)
+	}
+	return domerr.Ok(&RedactingWriter[W]{inner: w, patterns: compiled})
+}
+
+// Write redacts message and delegates.
+//
+// Contract:
+//   - Post: The wrapped writer receives message with every match replaced
+//   - Post: The wrapped writer's Result is returned unchanged
+func (rw *RedactingWriter[W]) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	for _, re := range rw.patterns {
+		message = re.ReplaceAllLiteralString(message, Redacted)
+	}
+	return rw.inner.Write(ctx, message)
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter_test
+
+import (
+	"context"
+	"testing"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/infrastructure/adapter"
+)
+
+// TestInfrastructureAdapterRedactingWriter tests the redaction decorator.
+func TestInfrastructureAdapterRedactingWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.RedactingWriter")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Matching substrings are redacted
+	// ========================================================================
+
+	inner := &recordingWriter{}
+	created := adapter.NewRedactingWriter(inner, []string{`\d{3}-\d{2}-\d{4}`, `(?i)secret`})
+	tf.RunTest("Construct - IsOk", created.IsOk())
+	if created.IsOk() {
+		writer := created.Value()
+		tf.RunTest("Redact - Write returns Ok",
+			writer.Write(ctx, "Hello, Alice 123-45-6789 and SECRET!").IsOk())
+		writer.Write(ctx, "Hello, Bob!")
+		tf.AssertEqual("Redact - matches replaced, others pass through", inner.messages, []string{
+			"Hello, Alice [REDACTED] and [REDACTED]!",
+			"Hello, Bob!",
+		})
+	}
+
+	// ========================================================================
+	// Test: No patterns is a passthrough
+	// ========================================================================
+
+	inner = &recordingWriter{}
+	adapter.NewRedactingWriter(inner, nil).Value().Write(ctx, "Hello, $1!")
+	tf.AssertEqual("No patterns - unchanged", inner.messages, []string{"Hello, $1!"})
+
+	// ========================================================================
+	// Test: Invalid patterns are rejected at construction
+	// ========================================================================
+
+	bad := adapter.NewRedactingWriter(&recordingWriter{}, []string{`ok`, `(unclosed`})
+	tf.AssertError("Invalid regexp - ValidationError", bad, apperr.ValidationError)
+	empty := adapter.NewRedactingWriter(&recordingWriter{}, []string{""})
+	tf.AssertError("Empty pattern - ValidationError", empty, apperr.ValidationError)
+	if empty.IsError() {
+		tf.AssertEqual("Empty pattern - message", empty.ErrorInfo().Message, "redaction pattern 0 is empty")
+	}
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
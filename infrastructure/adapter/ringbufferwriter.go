@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Writer decorator that retains the most recent messages
+
+package adapter
+
+import (
+	"context"
+	"sync"
+
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	"github.com/abitofhelp/hybrid_app_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+// RingBufferWriter is a decorator that keeps the last N successfully
+// written messages in a circular buffer for diagnostics (e.g., a
+// /debug/last endpoint), while the wrapped writer does the real output.
+//
+// The buffer is guarded by a mutex, so a RingBufferWriter may be shared
+// across goroutines as long as the wrapped writer is.
+//
+// Implements: outbound.WriterPort
+type RingBufferWriter[W outbound.WriterPort] struct {
+	inner W
+	mu    sync.Mutex
+	ring  []string
+	next  int
+	full  bool
+}
+
+// NewRingBufferWriter wraps w, retaining up to capacity recent messages.
+// A capacity below 1 is treated as 1.
+//
+// Usage:
+//
+//	writer := adapter.NewRingBufferWriter(adapter.NewConsoleWriter(), 100)
+//	writer.Write(ctx, "Hello, Alice!")
+//	recent := writer.Snapshot() // ["Hello, Alice!"]
+func NewRingBufferWriter[W outbound.WriterPort](w W, capacity int) *RingBufferWriter[W] {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &RingBufferWriter[W]{inner: w, ring: make([]string, capacity)}
+}
+
+// Write delegates to the wrapped writer and records message if it succeeded.
+//
+// Contract:
+//   - Post: The wrapped writer's Result is returned unchanged
+//   - Post: On Ok, message is retained, evicting the oldest when full
+//   - Post: On Err, the buffer is unchanged
+func (rw *RingBufferWriter[W]) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	result := rw.inner.Write(ctx, message)
+	if result.IsOk() {
+		rw.mu.Lock()
+		rw.ring[rw.next] = message
+		rw.next = (rw.next + 1) % len(rw.ring)
+		if rw.next == 0 {
+			rw.full = true
+		}
+		rw.mu.Unlock()
+	}
+	return result
+}
+
+// Snapshot returns the retained messages, oldest first. The slice is a
+// copy; modifying it does not affect the buffer.
+func (rw *RingBufferWriter[W]) Snapshot() []string {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	if !rw.full {
+		return append([]string(nil), rw.ring[:rw.next]...)
+	}
+	snapshot := make([]string, 0, len(rw.ring))
+	snapshot = append(snapshot, rw.ring[rw.next:]...)
+	return append(snapshot, rw.ring[:rw.next]...)
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/infrastructure/adapter"
+)
+
+// TestInfrastructureAdapterRingBufferWriter tests the recent-messages decorator.
+func TestInfrastructureAdapterRingBufferWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.RingBufferWriter")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Fresh writer has an empty snapshot
+	// ========================================================================
+
+	inner := &recordingWriter{}
+	writer := adapter.NewRingBufferWriter(inner, 3)
+	tf.AssertEqual("Fresh - empty snapshot", len(writer.Snapshot()), 0)
+
+	// ========================================================================
+	// Test: Below capacity - all messages retained, oldest first
+	// ========================================================================
+
+	tf.RunTest("Below - Write returns Ok", writer.Write(ctx, "one").IsOk())
+	writer.Write(ctx, "two")
+	tf.AssertEqual("Below - snapshot", writer.Snapshot(), []string{"one", "two"})
+	tf.AssertEqual("Below - inner received messages", inner.messages, []string{"one", "two"})
+
+	// ========================================================================
+	// Test: Past capacity - only the most recent N retained
+	// ========================================================================
+
+	writer.Write(ctx, "three")
+	tf.AssertEqual("Full - snapshot", writer.Snapshot(), []string{"one", "two", "three"})
+	writer.Write(ctx, "four")
+	writer.Write(ctx, "five")
+	tf.AssertEqual("Wrapped - most recent 3", writer.Snapshot(), []string{"three", "four", "five"})
+	tf.AssertEqual("Wrapped - inner received every message", len(inner.messages), 5)
+
+	// ========================================================================
+	// Test: Snapshot is a copy
+	// ========================================================================
+
+	snapshot := writer.Snapshot()
+	snapshot[0] = "mutated"
+	tf.AssertEqual("Copy - buffer unaffected", writer.Snapshot(), []string{"three", "four", "five"})
+
+	// ========================================================================
+	// Test: Failed writes are propagated and not retained
+	// ========================================================================
+
+	failing := adapter.NewRingBufferWriter(&failingWriter{}, 3)
+	tf.AssertError("Failure - error propagated", failing.Write(ctx, "lost"), apperr.InfrastructureError)
+	tf.AssertEqual("Failure - not retained", len(failing.Snapshot()), 0)
+
+	// ========================================================================
+	// Test: Capacity below 1 retains the latest message
+	// ========================================================================
+
+	single := adapter.NewRingBufferWriter(&recordingWriter{}, 0)
+	single.Write(ctx, "a")
+	single.Write(ctx, "b")
+	tf.AssertEqual("Zero capacity - treated as 1", single.Snapshot(), []string{"b"})
+
+	// ========================================================================
+	// Test: Concurrent writes and snapshots
+	// ========================================================================
+
+	const goroutines, perGoroutine = 8, 25
+	shared := adapter.NewRingBufferWriter(adapter.NewNoopWriter(), 10)
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < perGoroutine; i++ {
+				shared.Write(ctx, fmt.Sprintf("Hello, worker-%02d-%02d!", g, i))
+				shared.Snapshot()
+			}
+		}(g)
+	}
+	wg.Wait()
+	tf.AssertEqual("Concurrent - full buffer", len(shared.Snapshot()), 10)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
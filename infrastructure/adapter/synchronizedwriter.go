@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Writer decorator that serializes concurrent writes
+
+package adapter
+
+import (
+	"context"
+	"sync"
+
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	"github.com/abitofhelp/hybrid_app_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+// SynchronizedWriter is a decorator that lets goroutines share a writer
+// that is not itself safe for concurrent use (e.g., a ConsoleWriter on
+// stdout during a concurrent batch greet).
+//
+// Each Write holds a mutex for the whole call to the wrapped writer, so a
+// message and its line terminator are never interleaved with another.
+//
+// Implements: outbound.WriterPort
+type SynchronizedWriter[W outbound.WriterPort] struct {
+	mu    sync.Mutex
+	inner W
+}
+
+// NewSynchronizedWriter wraps w so that writes are serialized.
+//
+// Usage:
+//
+//	writer := adapter.NewSynchronizedWriter(adapter.NewConsoleWriter())
+//	uc := usecase.NewGreetAllUseCase(writer)
+func NewSynchronizedWriter[W outbound.WriterPort](w W) *SynchronizedWriter[W] {
+	return &SynchronizedWriter[W]{inner: w}
+}
+
+// Write delegates to the wrapped writer while holding the lock.
+//
+// Contract:
+//   - Post: The wrapped writer's Result is returned unchanged
+//   - Post: At most one call to the wrapped writer is in progress at a time
+func (sw *SynchronizedWriter[W]) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	sw.mu.Lock()
+	defer sw.mu.Unlock()
+	return sw.inner.Write(ctx, message)
+}
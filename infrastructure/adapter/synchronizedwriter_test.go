@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter_test
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/infrastructure/adapter"
+)
+
+// byteWriter is a deliberately unsafe WriterPort test double: it appends
+// a message one byte at a time, yielding between bytes, so unsynchronized
+// concurrent writes interleave.
+type byteWriter struct {
+	out []byte
+}
+
+func (w *byteWriter) Write(_ context.Context, message string) domerr.Result[model.Unit] {
+	for i := 0; i < len(message); i++ {
+		w.out = append(w.out, message[i])
+		runtime.Gosched()
+	}
+	w.out = append(w.out, '\n')
+	return domerr.Ok(model.UnitValue)
+}
+
+// TestInfrastructureAdapterSynchronizedWriter tests the serializing decorator.
+func TestInfrastructureAdapterSynchronizedWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.SynchronizedWriter")
+	ctx := context.Background()
+
+	// ========================================================================
+	// Test: Concurrent writes produce intact, non-interleaved lines
+	// ========================================================================
+
+	const goroutines, perGoroutine = 16, 25
+	inner := &byteWriter{}
+	writer := adapter.NewSynchronizedWriter(inner)
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < perGoroutine; i++ {
+				writer.Write(ctx, fmt.Sprintf("Hello, worker-%02d-%02d!", g, i))
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	lines := strings.Split(strings.TrimSuffix(string(inner.out), "\n"), "\n")
+	tf.AssertEqual("Concurrent - line count", len(lines), goroutines*perGoroutine)
+
+	seen := make(map[string]bool, len(lines))
+	for _, line := range lines {
+		seen[line] = true
+	}
+	missing := 0
+	for g := 0; g < goroutines; g++ {
+		for i := 0; i < perGoroutine; i++ {
+			if !seen[fmt.Sprintf("Hello, worker-%02d-%02d!", g, i)] {
+				missing++
+			}
+		}
+	}
+	tf.AssertEqual("Concurrent - every line intact", missing, 0)
+
+	// ========================================================================
+	// Test: Results pass through unchanged
+	// ========================================================================
+
+	tf.RunTest("Passthrough - Ok", adapter.NewSynchronizedWriter(&recordingWriter{}).Write(ctx, "Hi").IsOk())
+	tf.AssertError("Passthrough - Err",
+		adapter.NewSynchronizedWriter(&failingWriter{}).Write(ctx, "Hi"), apperr.InfrastructureError)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Portable syslog priority for the syslog writer adapter
+
+package adapter
+
+// SyslogPriority is a syslog facility combined with a severity, e.g.
+// SyslogUser|SyslogInfo. It mirrors log/syslog.Priority (same values) so
+// NewSyslogWriter keeps one signature on every GOOS, including Windows and
+// Plan 9 where log/syslog does not exist.
+type SyslogPriority int
+
+// Severities, from most to least severe (RFC 5424).
+const (
+	SyslogEmerg SyslogPriority = iota
+	SyslogAlert
+	SyslogCrit
+	SyslogErr
+	SyslogWarning
+	SyslogNotice
+	SyslogInfo
+	SyslogDebug
+)
+
+// Facilities (RFC 5424).
+const (
+	SyslogKern SyslogPriority = iota << 3
+	SyslogUser
+	SyslogMail
+	SyslogDaemon
+	SyslogAuth
+	SyslogSyslog
+	SyslogLpr
+	SyslogNews
+	SyslogUucp
+	SyslogCron
+	SyslogAuthpriv
+	SyslogFtp
+	_ // unused
+	_ // unused
+	_ // unused
+	_ // unused
+	SyslogLocal0
+	SyslogLocal1
+	SyslogLocal2
+	SyslogLocal3
+	SyslogLocal4
+	SyslogLocal5
+	SyslogLocal6
+	SyslogLocal7
+)
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Writer adapter that sends messages to syslog
+
+//go:build !windows && !plan9
+
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"log/syslog"
+	"sync"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+// NewSyslogWriter returns a writer that sends each message to the local
+// syslog daemon with the given tag and priority (facility|severity), plus
+// a close function that releases the connection.
+//
+// log/syslog does not exist on Windows or Plan 9, so this file is not
+// built there; syslogwriter_unsupported.go returns an InfrastructureError
+// instead. Where it is built but no syslog daemon is reachable (e.g., a
+// minimal container without /dev/log), NewSyslogWriter also returns an
+// InfrastructureError instead of a writer; it never panics.
+//
+// Usage:
+//
+//	writer, closeWriter, err := adapter.NewSyslogWriter("greeter", adapter.SyslogInfo|adapter.SyslogUser)
+//	if err != nil {
+//	    // fall back to adapter.NewConsoleWriter()
+//	}
+//	defer closeWriter()
+func NewSyslogWriter(tag string, priority SyslogPriority) (WriterFunc, func() error, error) {
+	return DialSyslogWriter("", "", tag, priority)
+}
+
+// DialSyslogWriter is NewSyslogWriter for a syslog server at raddr over
+// network ("udp", "tcp", "unixgram", ...); an empty network connects to
+// the local daemon.
+//
+// Contract:
+//   - Post: Returns an error (an apperr.ErrorType of kind
+//     InfrastructureError, "syslog unavailable: ...") if the connection
+//     cannot be established; the writer and close function are then nil
+//   - Post: Each Ok write sends exactly one syslog message (the daemon
+//     adds the timestamp, host, and tag)
+//   - Post: Write returns Err(InfrastructureError) if ctx is cancelled
+//     (nothing sent), the send fails, or close has been called
+//   - Post: close is idempotent; only the first call can return an error
+//   - Post: Safe for concurrent use
+func DialSyslogWriter(network, raddr, tag string, priority SyslogPriority) (WriterFunc, func() error, error) {
+	sw, err := syslog.Dial(network, raddr, syslog.Priority(priority), tag)
+	if err != nil {
+		return nil, nil, apperr.NewInfrastructureError(fmt.Sprintf("syslog unavailable: %v", err))
+	}
+
+	var (
+		mu     sync.Mutex
+		closed bool
+	)
+
+	write := func(ctx context.Context, message string) domerr.Result[model.Unit] {
+		if ctx.Err() != nil {
+			return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				withRequestID(ctx, fmt.Sprintf("write cancelled: %v", ctx.Err()))))
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		// syslog.Writer silently reconnects after Close, so track it here
+		if closed {
+			return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				withRequestID(ctx, "write failed: writer closed")))
+		}
+		if _, err := sw.Write([]byte(message)); err != nil {
+			return domerr.Err[model.Unit](apperr.NewInfrastructureError(
+				withRequestID(ctx, fmt.Sprintf("write failed: %v", err))))
+		}
+		return domerr.Ok(model.UnitValue)
+	}
+
+	closeWriter := func() error {
+		mu.Lock()
+		defer mu.Unlock()
+		if closed {
+			return nil
+		}
+		closed = true
+		if err := sw.Close(); err != nil {
+			return apperr.NewInfrastructureError(fmt.Sprintf("syslog close failed: %v", err))
+		}
+		return nil
+	}
+
+	return write, closeWriter, nil
+}
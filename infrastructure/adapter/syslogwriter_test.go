@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+//go:build !windows && !plan9
+
+package adapter_test
+
+import (
+	"context"
+	"errors"
+	"log/syslog"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	appctx "github.com/abitofhelp/hybrid_app_go/application/context"
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/infrastructure/adapter"
+)
+
+// TestInfrastructureAdapterSyslogWriter tests the syslog adapter against a
+// local UDP receiver standing in for a syslog daemon.
+func TestInfrastructureAdapterSyslogWriter(t *testing.T) {
+	receiver, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("no local UDP socket for a mock syslog receiver: %v", err)
+	}
+	defer receiver.Close()
+
+	tf := test.New("Infrastructure.Adapter.SyslogWriter")
+	ctx := context.Background()
+
+	// receive returns the next datagram, or "" after timeout.
+	receive := func(timeout time.Duration) string {
+		buf := make([]byte, 2048)
+		_ = receiver.SetReadDeadline(time.Now().Add(timeout))
+		n, _, err := receiver.ReadFrom(buf)
+		if err != nil {
+			return ""
+		}
+		return string(buf[:n])
+	}
+
+	// ========================================================================
+	// Test: A write reaches the receiver with priority and tag
+	// ========================================================================
+
+	writer, closeWriter, err := adapter.DialSyslogWriter("udp", receiver.LocalAddr().String(),
+		"greeter", adapter.SyslogInfo|adapter.SyslogUser)
+	tf.RunTest("Dial - no error", err == nil)
+	if err != nil {
+		tf.Summary(t)
+		return
+	}
+
+	tf.RunTest("Write - Ok", writer.Write(ctx, "Hello, Alice!").IsOk())
+	packet := receive(2 * time.Second)
+	// <14> = facility user (1) * 8 + severity info (6)
+	tf.RunTest("Write - priority header", strings.HasPrefix(packet, "<14>"))
+	tf.RunTest("Write - tag", strings.Contains(packet, " greeter["))
+	tf.RunTest("Write - message", strings.HasSuffix(strings.TrimSuffix(packet, "\n"), ": Hello, Alice!"))
+
+	// ========================================================================
+	// Test: Cancellation and writes after close fail without sending
+	// ========================================================================
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	tf.AssertError("Cancelled - InfrastructureError", writer.Write(cancelled, "Hi"), apperr.InfrastructureError)
+
+	tf.RunTest("Close - no error", closeWriter() == nil)
+	tf.RunTest("Close again - no error", closeWriter() == nil)
+	result := writer.Write(appctx.WithRequestID(ctx, "req-7"), "Hi")
+	tf.AssertError("After close - InfrastructureError", result, apperr.InfrastructureError)
+	tf.AssertEqual("After close - message", result.ErrorInfo().Message,
+		"write failed: writer closed [request_id=req-7]")
+	tf.AssertEqual("After close - nothing sent", receive(100*time.Millisecond), "")
+
+	// ========================================================================
+	// Test: Unreachable daemon - clear InfrastructureError, no panic
+	// ========================================================================
+
+	writer, closeWriter, err = adapter.DialSyslogWriter("unixgram", t.TempDir()+"/missing.sock",
+		"greeter", adapter.SyslogInfo)
+	var infoErr apperr.ErrorType
+	tf.RunTest("Unavailable - ErrorType", errors.As(err, &infoErr))
+	tf.AssertEqual("Unavailable - InfrastructureError", infoErr.Kind, apperr.InfrastructureError)
+	tf.RunTest("Unavailable - clear message", strings.HasPrefix(infoErr.Message, "syslog unavailable: "))
+	tf.RunTest("Unavailable - no writer or close", writer == nil && closeWriter == nil)
+
+	// ========================================================================
+	// Test: SyslogPriority mirrors log/syslog
+	// ========================================================================
+
+	for _, tc := range []struct {
+		name string
+		got  adapter.SyslogPriority
+		want syslog.Priority
+	}{
+		{"Emerg", adapter.SyslogEmerg, syslog.LOG_EMERG},
+		{"Debug", adapter.SyslogDebug, syslog.LOG_DEBUG},
+		{"Kern", adapter.SyslogKern, syslog.LOG_KERN},
+		{"User", adapter.SyslogUser, syslog.LOG_USER},
+		{"Ftp", adapter.SyslogFtp, syslog.LOG_FTP},
+		{"Local0", adapter.SyslogLocal0, syslog.LOG_LOCAL0},
+		{"Local7|Warning", adapter.SyslogLocal7 | adapter.SyslogWarning, syslog.LOG_LOCAL7 | syslog.LOG_WARNING},
+	} {
+		tf.AssertEqual("Priority "+tc.name, int(tc.got), int(tc.want))
+	}
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Syslog writer fallback where log/syslog does not exist
+
+//go:build windows || plan9
+
+package adapter
+
+import (
+	"fmt"
+	"runtime"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+)
+
+// NewSyslogWriter reports that syslog is unavailable: log/syslog does not
+// exist on Windows or Plan 9.
+//
+// Contract:
+//   - Post: Always returns a nil writer and close function and an
+//     InfrastructureError ("syslog unavailable on <GOOS>")
+func NewSyslogWriter(tag string, priority SyslogPriority) (WriterFunc, func() error, error) {
+	return DialSyslogWriter("", "", tag, priority)
+}
+
+// DialSyslogWriter reports that syslog is unavailable, like
+// NewSyslogWriter.
+func DialSyslogWriter(network, raddr, tag string, priority SyslogPriority) (WriterFunc, func() error, error) {
+	return nil, nil, apperr.NewInfrastructureError(fmt.Sprintf("syslog unavailable on %s", runtime.GOOS))
+}
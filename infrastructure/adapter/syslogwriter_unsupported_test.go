@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+//go:build windows || plan9
+
+package adapter_test
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/infrastructure/adapter"
+)
+
+// TestInfrastructureAdapterSyslogWriter tests the syslog fallback on
+// platforms without log/syslog.
+func TestInfrastructureAdapterSyslogWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.SyslogWriter")
+
+	// ========================================================================
+	// Test: Unsupported GOOS - clear InfrastructureError, no writer
+	// ========================================================================
+
+	writer, closeWriter, err := adapter.NewSyslogWriter("greeter", adapter.SyslogInfo|adapter.SyslogUser)
+	var infoErr apperr.ErrorType
+	tf.RunTest("Unsupported - ErrorType", errors.As(err, &infoErr))
+	tf.AssertEqual("Unsupported - InfrastructureError", infoErr.Kind, apperr.InfrastructureError)
+	tf.AssertEqual("Unsupported - names GOOS", infoErr.Message, "syslog unavailable on "+runtime.GOOS)
+	tf.RunTest("Unsupported - no writer or close", writer == nil && closeWriter == nil)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
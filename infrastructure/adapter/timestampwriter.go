@@ -0,0 +1,52 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: adapter
+// Description: Writer decorator that prefixes messages with a timestamp
+
+package adapter
+
+import (
+	"context"
+	"time"
+
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	"github.com/abitofhelp/hybrid_app_go/application/port/outbound"
+	domerr "github.com/abitofhelp/hybrid_app_go/domain/error"
+)
+
+// TimestampWriter is a decorator that prepends an RFC3339 timestamp to each
+// message before delegating to the wrapped writer.
+//
+// Output format: "<RFC3339 timestamp> <message>"
+// Example:       "2025-01-02T03:04:05Z Hello, Alice!"
+//
+// Static Dispatch:
+//   - Generic over both the wrapped writer W and the clock C
+//   - TimestampWriter[*ConsoleWriter, SystemClock] is fully resolved at compile time
+//
+// Implements: outbound.WriterPort
+type TimestampWriter[W outbound.WriterPort, C outbound.ClockPort] struct {
+	inner W
+	clock C
+}
+
+// NewTimestampWriter wraps w so every message is prefixed with clock.Now().
+//
+// Usage:
+//
+//	writer := adapter.NewTimestampWriter(adapter.NewConsoleWriter(), adapter.SystemClock{})
+//	result := writer.Write(ctx, "Hello, Alice!")
+//	// stdout: 2025-01-02T03:04:05Z Hello, Alice!
+func NewTimestampWriter[W outbound.WriterPort, C outbound.ClockPort](w W, clock C) *TimestampWriter[W, C] {
+	return &TimestampWriter[W, C]{inner: w, clock: clock}
+}
+
+// Write prefixes message with the current timestamp and delegates.
+//
+// Contract:
+//   - Post: The wrapped writer receives "<RFC3339> <message>"
+//   - Post: The wrapped writer's Result is returned unchanged
+func (tw *TimestampWriter[W, C]) Write(ctx context.Context, message string) domerr.Result[model.Unit] {
+	stamp := tw.clock.Now().Format(time.RFC3339)
+	return tw.inner.Write(ctx, stamp+" "+message)
+}
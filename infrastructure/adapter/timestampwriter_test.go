@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package adapter_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/infrastructure/adapter"
+)
+
+// TestInfrastructureAdapterTimestampWriter tests the timestamp decorator.
+func TestInfrastructureAdapterTimestampWriter(t *testing.T) {
+	tf := test.New("Infrastructure.Adapter.TimestampWriter")
+	ctx := context.Background()
+	clock := fixedClock{at: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)}
+
+	// ========================================================================
+	// Test: Message is prefixed with RFC3339 timestamp
+	// ========================================================================
+
+	inner := &recordingWriter{}
+	writer := adapter.NewTimestampWriter(inner, clock)
+	result := writer.Write(ctx, "Hello, Alice!")
+	tf.RunTest("Prefix - Write returns Ok", result.IsOk())
+	tf.AssertEqual("Prefix - exact prefixed format",
+		inner.messages, []string{"2025-01-02T03:04:05Z Hello, Alice!"})
+
+	// ========================================================================
+	// Test: Non-UTC zone offset is preserved
+	// ========================================================================
+
+	zone := time.FixedZone("UTC-5", -5*60*60)
+	inner = &recordingWriter{}
+	adapter.NewTimestampWriter(inner, fixedClock{at: time.Date(2025, 1, 2, 3, 4, 5, 0, zone)}).
+		Write(ctx, "Hi")
+	tf.AssertEqual("Zone offset - RFC3339 with offset",
+		inner.messages, []string{"2025-01-02T03:04:05-05:00 Hi"})
+
+	// ========================================================================
+	// Test: Writer failure propagates unchanged
+	// ========================================================================
+
+	failing := &failingWriter{}
+	failed := adapter.NewTimestampWriter(failing, clock).Write(ctx, "Hello, Alice!")
+	tf.AssertError("Failure - error propagated", failed, apperr.InfrastructureError)
+	if failed.IsError() {
+		tf.AssertEqual("Failure - message unchanged",
+			failed.ErrorInfo().Message, "sink unavailable")
+	}
+	tf.AssertEqual("Failure - inner writer called once", failing.calls, 1)
+
+	// Print summary and fail test if any failed
+	tf.Summary(t)
+}
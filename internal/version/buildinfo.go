@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: version
+// Description: Build information (commit, build date) set via -ldflags
+
+package version
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// Build metadata injected at link time, e.g.:
+//
+//	go build -ldflags "-X github.com/abitofhelp/hybrid_app_go/internal/version.Commit=abc1234 \
+//	  -X github.com/abitofhelp/hybrid_app_go/internal/version.BuildDate=2025-01-01T00:00:00Z"
+//
+// Empty values fall back to runtime/debug.ReadBuildInfo (see Info).
+var (
+	Commit    string
+	BuildDate string
+)
+
+// unknown is reported for any field that neither -ldflags nor the
+// embedded build info could provide.
+const unknown = "unknown"
+
+// BuildInfo describes the running binary.
+type BuildInfo struct {
+	Version   string
+	Commit    string
+	BuildDate string
+}
+
+// Info returns the build information for the running binary.
+//
+// Contract:
+//   - Post: Version is always the Version constant (single source of truth)
+//   - Post: Commit/BuildDate prefer -ldflags values, then the VCS settings
+//     recorded by the Go toolchain, then "unknown"
+func Info() BuildInfo {
+	info := BuildInfo{Version: Version, Commit: Commit, BuildDate: BuildDate}
+
+	if info.Commit == "" || info.BuildDate == "" {
+		if bi, ok := debug.ReadBuildInfo(); ok {
+			for _, s := range bi.Settings {
+				switch {
+				case s.Key == "vcs.revision" && info.Commit == "":
+					info.Commit = s.Value
+				case s.Key == "vcs.time" && info.BuildDate == "":
+					info.BuildDate = s.Value
+				}
+			}
+		}
+	}
+
+	if info.Commit == "" {
+		info.Commit = unknown
+	}
+	if info.BuildDate == "" {
+		info.BuildDate = unknown
+	}
+	return info
+}
+
+// String formats the build info as "v1.0.0 (commit abc1234, built 2025-01-01T00:00:00Z)".
+func (b BuildInfo) String() string {
+	return fmt.Sprintf("v%s (commit %s, built %s)", b.Version, b.Commit, b.BuildDate)
+}
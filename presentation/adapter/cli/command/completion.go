@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: command
+// Description: CLI command printing shell completion scripts
+
+package command
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// completionShells are the shells accepted by `greeter completion`.
+var completionShells = []string{"bash", "zsh", "fish"}
+
+// CompletionCommand is a CLI command handler that prints a completion
+// script for bash, zsh, or fish.
+//
+// The script is a static template filled in with the subcommand names
+// registered by bootstrap and the greet flags, so it stays in step with
+// the binary without a hand-maintained list.
+type CompletionCommand struct {
+	subcommands []string
+	config      commandConfig
+}
+
+// NewCompletionCommand creates a CompletionCommand that completes
+// subcommands (in the given order) and the greet flags.
+// It accepts the same options as NewGreetCommand; WithOutput redirects
+// the script.
+func NewCompletionCommand(subcommands []string, opts ...CommandOption) *CompletionCommand {
+	cfg := commandConfig{writerName: "unknown"}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &CompletionCommand{
+		subcommands: append([]string(nil), subcommands...),
+		config:      cfg,
+	}
+}
+
+// Run prints the completion script for the shell named by args[1].
+//
+// CLI Usage: greeter completion bash|zsh|fish
+// Example: source <(./greeter completion bash)
+//
+// Contract:
+//   - Post: Returns 0 after printing the script to stdout (see WithOutput)
+//   - Post: Returns 1 with usage on stderr unless exactly one shell is given
+//   - Post: Returns 2 with an UNSUPPORTED_OPTION error for an unknown shell
+func (c *CompletionCommand) Run(args []string) int {
+	errOut := c.config.errorOutput()
+	programName := "greeter completion"
+	if len(args) > 0 {
+		programName = args[0]
+	}
+	if len(args) != 2 {
+		fmt.Fprintf(errOut, "Usage: %s %s\n", programName, strings.Join(completionShells, "|"))
+		return ExitFailure
+	}
+
+	shell := ParseEnum(args[1], completionShells)
+	if shell.IsError() {
+		reportError(errOut, shell.ErrorInfo(), true)
+		return ExitCodeFor(shell.ErrorInfo())
+	}
+
+	// The completed binary is the first word of "<program> completion"
+	binary := filepath.Base(strings.Fields(programName + " ")[0])
+	out := c.config.output()
+	switch shell.Value() {
+	case "bash":
+		writeBashCompletion(out, binary, c.subcommands)
+	case "zsh":
+		writeZshCompletion(out, binary, c.subcommands)
+	case "fish":
+		writeFishCompletion(out, binary, c.subcommands)
+	}
+	return ExitSuccess
+}
+
+// completionFlag is a greet flag as offered for completion.
+type completionFlag struct {
+	name  string // without dashes
+	usage string
+}
+
+// spelling returns the flag as typed: -v for shorthands, --name otherwise.
+func (f completionFlag) spelling() string {
+	if len(f.name) == 1 {
+		return "-" + f.name
+	}
+	return "--" + f.name
+}
+
+// completionFlags returns the greet flags in lexical order.
+func completionFlags() []completionFlag {
+	var flags []completionFlag
+	newFlagSet(&Options{}).VisitAll(func(f *flag.Flag) {
+		flags = append(flags, completionFlag{name: f.Name, usage: f.Usage})
+	})
+	return flags
+}
+
+// flagSpellings returns the greet flags as typed, space-separated.
+func flagSpellings() string {
+	flags := completionFlags()
+	words := make([]string, len(flags))
+	for i, f := range flags {
+		words[i] = f.spelling()
+	}
+	return strings.Join(words, " ")
+}
+
+// shellIdentifier turns binary into a valid shell function name part.
+func shellIdentifier(binary string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '_'
+	}, binary)
+}
+
+// writeBashCompletion writes a script for `source <(greeter completion bash)`.
+func writeBashCompletion(w io.Writer, binary string, subcommands []string) {
+	fn := "_" + shellIdentifier(binary) + "_completions"
+	fmt.Fprintf(w, `# bash completion for %[1]s
+%[2]s() {
+    local cur="${COMP_WORDS[COMP_CWORD]}"
+    local prev="${COMP_WORDS[COMP_CWORD-1]}"
+    if [[ $prev == completion ]]; then
+        COMPREPLY=($(compgen -W "%[5]s" -- "$cur"))
+    elif [[ $cur == -* ]]; then
+        COMPREPLY=($(compgen -W "%[4]s" -- "$cur"))
+    elif [[ $COMP_CWORD -eq 1 ]]; then
+        COMPREPLY=($(compgen -W "%[3]s" -- "$cur"))
+    fi
+}
+complete -F %[2]s %[1]s
+`, binary, fn, strings.Join(subcommands, " "), flagSpellings(), strings.Join(completionShells, " "))
+}
+
+// writeZshCompletion writes a script for `source <(greeter completion zsh)`.
+func writeZshCompletion(w io.Writer, binary string, subcommands []string) {
+	fn := "_" + shellIdentifier(binary)
+	fmt.Fprintf(w, `#compdef %[1]s
+%[2]s() {
+    local -a subcommands flags shells
+    subcommands=(%[3]s)
+    flags=(%[4]s)
+    shells=(%[5]s)
+    if [[ ${words[CURRENT-1]} == completion ]]; then
+        compadd -a shells
+    elif [[ $PREFIX == -* ]]; then
+        compadd -a flags
+    elif (( CURRENT == 2 )); then
+        compadd -a subcommands
+    fi
+}
+compdef %[2]s %[1]s
+`, binary, fn, strings.Join(subcommands, " "), flagSpellings(), strings.Join(completionShells, " "))
+}
+
+// writeFishCompletion writes a script for `greeter completion fish | source`.
+func writeFishCompletion(w io.Writer, binary string, subcommands []string) {
+	fmt.Fprintf(w, "# fish completion for %s\n", binary)
+	fmt.Fprintf(w, "complete -c %s -f -n __fish_use_subcommand -a '%s'\n", binary, strings.Join(subcommands, " "))
+	fmt.Fprintf(w, "complete -c %s -f -n '__fish_seen_subcommand_from completion' -a '%s'\n",
+		binary, strings.Join(completionShells, " "))
+	for _, f := range completionFlags() {
+		option := "-l"
+		if len(f.name) == 1 {
+			option = "-s"
+		}
+		usage := strings.ReplaceAll(f.usage, `'`, `\'`)
+		fmt.Fprintf(w, "complete -c %s %s %s -d '%s'\n", binary, option, f.name, usage)
+	}
+}
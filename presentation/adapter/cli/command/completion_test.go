@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package command_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	clicmd "github.com/abitofhelp/hybrid_app_go/presentation/adapter/cli/command"
+)
+
+func TestCompletionCommandRun(t *testing.T) {
+	subcommands := []string{"greet", "greet-all", "locales", "completion"}
+
+	tests := []struct {
+		name       string
+		args       []string
+		wantCode   int
+		wantOut    []string
+		wantErrOut string
+	}{
+		{
+			name:     "bash",
+			args:     []string{"greeter completion", "bash"},
+			wantCode: clicmd.ExitSuccess,
+			wantOut: []string{
+				`compgen -W "greet greet-all locales completion"`,
+				"--format", "--dry-run", " -v ",
+				"complete -F _greeter_completions greeter\n",
+			},
+		},
+		{
+			name:     "zsh",
+			args:     []string{"greeter completion", "zsh"},
+			wantCode: clicmd.ExitSuccess,
+			wantOut: []string{
+				"#compdef greeter\n",
+				"subcommands=(greet greet-all locales completion)",
+				"--punctuation",
+				"compdef _greeter greeter\n",
+			},
+		},
+		{
+			name:     "fish, case-insensitive",
+			args:     []string{"./bin/greeter completion", "Fish"},
+			wantCode: clicmd.ExitSuccess,
+			wantOut: []string{
+				"complete -c greeter -f -n __fish_use_subcommand -a 'greet greet-all locales completion'\n",
+				"complete -c greeter -l format -d 'output format: text or csv (overrides GREETER_FORMAT)'\n",
+				"complete -c greeter -s q -d 'shorthand for --quiet'\n",
+			},
+		},
+		{
+			name:       "missing shell",
+			args:       []string{"greeter completion"},
+			wantCode:   clicmd.ExitFailure,
+			wantErrOut: "Usage: greeter completion bash|zsh|fish\n",
+		},
+		{
+			name:       "unsupported shell",
+			args:       []string{"greeter completion", "tcsh"},
+			wantCode:   clicmd.ExitInvalidInput,
+			wantErrOut: `Error: UNSUPPORTED_OPTION: unsupported value "tcsh" (valid: bash, zsh, fish)` + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out, errOut bytes.Buffer
+			cmd := clicmd.NewCompletionCommand(subcommands, clicmd.WithOutput(&out), clicmd.WithErrorOutput(&errOut))
+
+			if got := cmd.Run(tt.args); got != tt.wantCode {
+				t.Errorf("Run() = %d, want %d", got, tt.wantCode)
+			}
+			for _, want := range tt.wantOut {
+				if !strings.Contains(out.String(), want) {
+					t.Errorf("stdout missing %q:\n%s", want, out.String())
+				}
+			}
+			if tt.wantOut == nil && out.Len() != 0 {
+				t.Errorf("stdout = %q, want empty", out.String())
+			}
+			if errOut.String() != tt.wantErrOut {
+				t.Errorf("stderr = %q, want %q", errOut.String(), tt.wantErrOut)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package command_test
+
+import (
+	"context"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_app_go/application/command"
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+)
+
+// stubUseCase is a GreetPort test double returning a fixed Result and
+// recording the commands it receives.
+type stubUseCase struct {
+	result   apperr.Result[model.Unit]
+	received []command.GreetCommand
+	contexts []context.Context
+	ctxErrs  []error // ctx.Err() at call time
+}
+
+func okUseCase() *stubUseCase {
+	return &stubUseCase{result: apperr.Ok(model.UnitValue)}
+}
+
+func errUseCase(err apperr.ErrorType) *stubUseCase {
+	return &stubUseCase{result: apperr.Err[model.Unit](err)}
+}
+
+func (s *stubUseCase) Execute(ctx context.Context, cmd command.GreetCommand) apperr.Result[model.Unit] {
+	s.received = append(s.received, cmd)
+	s.contexts = append(s.contexts, ctx)
+	s.ctxErrs = append(s.ctxErrs, ctx.Err())
+	return s.result
+}
+
+// stubBatchUseCase is a GreetAllPort test double returning a fixed Result
+// and recording the commands it receives.
+type stubBatchUseCase struct {
+	result   apperr.Result[model.GreetSummary]
+	received []command.GreetAllCommand
+}
+
+func (s *stubBatchUseCase) ExecutePartial(_ context.Context, cmd command.GreetAllCommand) apperr.Result[model.GreetSummary] {
+	s.received = append(s.received, cmd)
+	return s.result
+}
+
+// stubInteractiveUseCase is a GreetInteractivePort test double returning
+// a fixed Result.
+type stubInteractiveUseCase struct {
+	result apperr.Result[model.GreetSummary]
+	calls  int
+}
+
+func (s *stubInteractiveUseCase) ExecuteInteractive(_ context.Context) apperr.Result[model.GreetSummary] {
+	s.calls++
+	return s.result
+}
+
+// stubLocalesUseCase is a LocalesPort test double returning a fixed Result.
+type stubLocalesUseCase struct {
+	result apperr.Result[[]model.LocaleInfo]
+	calls  int
+}
+
+func (s *stubLocalesUseCase) Execute(_ context.Context, _ command.LocalesCommand) apperr.Result[[]model.LocaleInfo] {
+	s.calls++
+	return s.result
+}
+
+// captureStdout runs fn with os.Stdout redirected to a pipe and returns
+// everything written to it. Only --version writes to stdout; stderr output
+// is captured by injecting a buffer with WithErrorOutput instead.
+func captureStdout(t *testing.T, fn func()) string {
+	t.Helper()
+	orig := os.Stdout
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("os.Pipe: %v", err)
+	}
+	os.Stdout = w
+	defer func() { os.Stdout = orig }()
+
+	fn()
+
+	w.Close()
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("reading captured stdout: %v", err)
+	}
+	return string(out)
+}
@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: command
+// Description: Uniform validation for enumerated flag values
+
+package command
+
+import (
+	"fmt"
+	"strings"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+)
+
+// CodeUnsupportedOption prefixes the message of every error for an
+// enumerated flag value (--case, --format, --output, a completion shell)
+// that is not one of its choices, so scripts can match on it regardless of
+// the flag.
+const CodeUnsupportedOption = "UNSUPPORTED_OPTION"
+
+// caseNames are the values accepted by --case (besides "", meaning none).
+// The domain remains the source of truth and re-validates the choice.
+var caseNames = []string{"none", "upper", "lower", "title"}
+
+// ParseEnum matches value against allowed, ignoring letter case and
+// surrounding space, and returns the allowed spelling. Every enumerated
+// flag goes through it, including the wiring flags bootstrap validates.
+//
+// Contract:
+//   - Post: Returns Ok(allowed[i]) for the first allowed[i] that matches
+//   - Post: Otherwise returns Err(ValidationError) with the message
+//     `UNSUPPORTED_OPTION: unsupported value "<value>" (valid: a, b, ...)`
+func ParseEnum(value string, allowed []string) apperr.Result[string] {
+	trimmed := strings.TrimSpace(value)
+	for _, choice := range allowed {
+		if strings.EqualFold(trimmed, choice) {
+			return apperr.Ok(choice)
+		}
+	}
+	return apperr.Err[string](apperr.NewValidationError(fmt.Sprintf(
+		"%s: unsupported value %q (valid: %s)", CodeUnsupportedOption, value, strings.Join(allowed, ", "))))
+}
@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package command
+
+import (
+	"testing"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+)
+
+func TestParseEnum(t *testing.T) {
+	formats := []string{"text", "csv"}
+	tests := []struct {
+		name  string
+		value string
+		want  apperr.Result[string]
+	}{
+		{"exact match", "csv", apperr.Ok("csv")},
+		{"case-insensitive", "CsV", apperr.Ok("csv")},
+		{"surrounding space", " text ", apperr.Ok("text")},
+		{"invalid lists choices", "xml", apperr.Err[string](apperr.NewValidationError(
+			`UNSUPPORTED_OPTION: unsupported value "xml" (valid: text, csv)`))},
+		{"empty is invalid", "", apperr.Err[string](apperr.NewValidationError(
+			`UNSUPPORTED_OPTION: unsupported value "" (valid: text, csv)`))},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := ParseEnum(tc.value, formats); got != tc.want {
+				t.Errorf("ParseEnum(%q) = %+v, want %+v", tc.value, got, tc.want)
+			}
+		})
+	}
+}
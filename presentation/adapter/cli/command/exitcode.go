@@ -0,0 +1,116 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: command
+// Description: Exit code mapping and error reporting for CLI commands
+
+package command
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+)
+
+// Process exit codes, following common CLI conventions.
+const (
+	// ExitSuccess indicates the command completed successfully.
+	ExitSuccess = 0
+
+	// ExitFailure indicates a system error or a usage error.
+	ExitFailure = 1
+
+	// ExitInvalidInput indicates the user supplied invalid input.
+	ExitInvalidInput = 2
+
+	// ExitPartial indicates a batch where some, but not all, inputs were
+	// invalid: the valid ones were processed.
+	ExitPartial = 3
+)
+
+// ExitCodeFor maps an error to the process exit code.
+//
+// Mapping:
+//   - ValidationError     -> ExitInvalidInput (2): user error, fix the input
+//   - InfrastructureError -> ExitFailure (1): system error, retry may help
+//   - NotFoundError       -> ExitInvalidInput (2): the input named nothing
+//   - Any other kind      -> ExitFailure (1)
+//
+// Success is not an error; commands return ExitSuccess (0) directly.
+func ExitCodeFor(err apperr.ErrorType) int {
+	switch err.Kind {
+	case apperr.ValidationError, apperr.NotFoundError:
+		return ExitInvalidInput
+	case apperr.InfrastructureError:
+		return ExitFailure
+	default:
+		return ExitFailure
+	}
+}
+
+// ExitCodeForSummary maps the summary of a lenient batch run to the
+// process exit code.
+//
+// Mapping:
+//   - Nothing skipped (including an empty batch) -> ExitSuccess (0)
+//   - Everything skipped                         -> ExitInvalidInput (2)
+//   - Some greeted, some skipped                 -> ExitPartial (3)
+func ExitCodeForSummary(s model.GreetSummary) int {
+	switch {
+	case s.Skipped == 0:
+		return ExitSuccess
+	case s.Greeted == 0:
+		return ExitInvalidInput
+	default:
+		return ExitPartial
+	}
+}
+
+// RunResult turns a use case Result into an exit code, reporting any error
+// to errOut.
+//
+// Contract:
+//   - Post: Ok returns ExitSuccess and writes nothing
+//   - Post: Err writes "Error: <message>" plus a hint for the error's Kind
+//     to errOut and returns ExitCodeFor(err)
+func RunResult(r apperr.Result[model.Unit], errOut io.Writer) int {
+	return runResult(r, errOut, false)
+}
+
+// runResult is RunResult with --quiet support: when quiet, only the
+// machine-usable "Error: <message>" line is written.
+func runResult(r apperr.Result[model.Unit], errOut io.Writer, quiet bool) int {
+	switch r.State() {
+	case apperr.StateOk:
+		return ExitSuccess
+	default:
+		reportError(errOut, r.ErrorInfo(), quiet)
+		return ExitCodeFor(r.ErrorInfo())
+	}
+}
+
+// reportError displays a user-friendly error message with a hint based on ErrorKind.
+// In quiet mode only the machine-usable "Error: <message>" line is printed.
+// Note: We use apperr types here but the error comes through domain layer
+func reportError(w io.Writer, domErr apperr.ErrorType, quiet bool) {
+	fmt.Fprintf(w, "Error: %s\n", domErr.Message)
+	if quiet {
+		return
+	}
+
+	switch {
+	case domErr.Kind == apperr.ValidationError && strings.HasPrefix(domErr.Message, CodeUnsupportedOption):
+		fmt.Fprintln(w, "Please choose one of the valid values.")
+
+	case domErr.Kind == apperr.ValidationError:
+		fmt.Fprintln(w, "Please provide a valid name.")
+
+	case domErr.Kind == apperr.InfrastructureError:
+		fmt.Fprintln(w, "A system error occurred.")
+
+	case domErr.Kind == apperr.NotFoundError:
+		fmt.Fprintln(w, "The requested item was not found.")
+	}
+}
@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+// Presentation tests use ONLY the stdlib testing package: the presentation
+// layer must not import domain/* (including domain/test).
+package command_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	clicmd "github.com/abitofhelp/hybrid_app_go/presentation/adapter/cli/command"
+)
+
+func TestExitCodeFor(t *testing.T) {
+	tests := []struct {
+		name string
+		err  apperr.ErrorType
+		want int
+	}{
+		{"validation error", apperr.NewValidationError("bad name"), clicmd.ExitInvalidInput},
+		{"infrastructure error", apperr.NewInfrastructureError("disk full"), clicmd.ExitFailure},
+		{"not found error", apperr.NewNotFoundError("no such person"), clicmd.ExitInvalidInput},
+		{"unknown kind", apperr.ErrorType{Kind: apperr.ErrorKind(99), Message: "?"}, clicmd.ExitFailure},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := clicmd.ExitCodeFor(tc.err); got != tc.want {
+				t.Errorf("ExitCodeFor(%v) = %d, want %d", tc.err, got, tc.want)
+			}
+		})
+	}
+
+	if clicmd.ExitInvalidInput != 2 || clicmd.ExitFailure != 1 || clicmd.ExitSuccess != 0 {
+		t.Errorf("exit code constants changed: success=%d failure=%d invalid=%d",
+			clicmd.ExitSuccess, clicmd.ExitFailure, clicmd.ExitInvalidInput)
+	}
+}
+
+func TestExitCodeForSummary(t *testing.T) {
+	tests := []struct {
+		name    string
+		summary model.GreetSummary
+		want    int
+	}{
+		{"all greeted", model.GreetSummary{Greeted: 3}, clicmd.ExitSuccess},
+		{"empty batch", model.GreetSummary{}, clicmd.ExitSuccess},
+		{"all skipped", model.GreetSummary{Skipped: 2}, clicmd.ExitInvalidInput},
+		{"mixed", model.GreetSummary{Greeted: 1, Skipped: 1}, clicmd.ExitPartial},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := clicmd.ExitCodeForSummary(tc.summary); got != tc.want {
+				t.Errorf("ExitCodeForSummary(%+v) = %d, want %d", tc.summary, got, tc.want)
+			}
+		})
+	}
+
+	if clicmd.ExitPartial != 3 {
+		t.Errorf("ExitPartial = %d, want 3", clicmd.ExitPartial)
+	}
+}
+
+func TestRunResult(t *testing.T) {
+	tests := []struct {
+		name    string
+		result  apperr.Result[model.Unit]
+		want    int
+		wantOut string
+	}{
+		{"ok", apperr.Ok(model.UnitValue), clicmd.ExitSuccess, ""},
+		{"validation error", apperr.Err[model.Unit](apperr.NewValidationError("bad name")),
+			clicmd.ExitInvalidInput, "Error: bad name\nPlease provide a valid name.\n"},
+		{"unsupported option", apperr.Err[model.Unit](apperr.NewValidationError(
+			`UNSUPPORTED_OPTION: unsupported value "xml" (valid: text, csv)`)), clicmd.ExitInvalidInput,
+			"Error: UNSUPPORTED_OPTION: unsupported value \"xml\" (valid: text, csv)\nPlease choose one of the valid values.\n"},
+		{"infrastructure error", apperr.Err[model.Unit](apperr.NewInfrastructureError("disk full")),
+			clicmd.ExitFailure, "Error: disk full\nA system error occurred.\n"},
+		{"not found error", apperr.Err[model.Unit](apperr.NewNotFoundError("no such person")),
+			clicmd.ExitInvalidInput, "Error: no such person\nThe requested item was not found.\n"},
+		{"unknown kind", apperr.Err[model.Unit](apperr.ErrorType{Kind: apperr.ErrorKind(99), Message: "?"}),
+			clicmd.ExitFailure, "Error: ?\n"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var errOut bytes.Buffer
+			if got := clicmd.RunResult(tc.result, &errOut); got != tc.want {
+				t.Errorf("RunResult() = %d, want %d", got, tc.want)
+			}
+			if errOut.String() != tc.wantOut {
+				t.Errorf("errOut = %q, want %q", errOut.String(), tc.wantOut)
+			}
+		})
+	}
+}
+
+func TestGreetCommandRun_ErrorOutput(t *testing.T) {
+	var errOut bytes.Buffer
+	uc := errUseCase(apperr.NewInfrastructureError("disk full"))
+	code := clicmd.NewGreetCommand(uc, clicmd.WithErrorOutput(&errOut)).Run([]string{"greeter", "Alice"})
+	if code != clicmd.ExitFailure {
+		t.Errorf("exit code = %d, want %d", code, clicmd.ExitFailure)
+	}
+	if want := "Error: disk full\nA system error occurred.\n"; errOut.String() != want {
+		t.Errorf("errOut = %q, want %q", errOut.String(), want)
+	}
+
+	errOut.Reset()
+	code = clicmd.NewGreetCommand(okUseCase(), clicmd.WithErrorOutput(&errOut)).Run([]string{"greeter", "-q", ""})
+	if code != clicmd.ExitInvalidInput {
+		t.Errorf("quiet: exit code = %d, want %d", code, clicmd.ExitInvalidInput)
+	}
+	if want := "Error: name is required\n"; errOut.String() != want {
+		t.Errorf("quiet: errOut = %q, want %q", errOut.String(), want)
+	}
+}
+
+func TestGreetCommandRun_ExitCodes(t *testing.T) {
+	tests := []struct {
+		name string
+		uc   *stubUseCase
+		args []string
+		want int
+	}{
+		{"success", okUseCase(), []string{"greeter", "Alice"}, 0},
+		{"validation error from use case", errUseCase(apperr.NewValidationError("too long")),
+			[]string{"greeter", "Alice"}, 2},
+		{"infrastructure error from use case", errUseCase(apperr.NewInfrastructureError("disk full")),
+			[]string{"greeter", "Alice"}, 1},
+		{"empty name rejected at boundary", okUseCase(), []string{"greeter", ""}, 2},
+		{"usage error", okUseCase(), []string{"greeter"}, 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := clicmd.NewGreetCommand(tc.uc, clicmd.WithErrorOutput(io.Discard))
+			if got := cmd.Run(tc.args); got != tc.want {
+				t.Errorf("Run(%q) = %d, want %d", tc.args, got, tc.want)
+			}
+		})
+	}
+}
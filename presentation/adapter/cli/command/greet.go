@@ -49,10 +49,13 @@ package command
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
+	"time"
 
 	"github.com/abitofhelp/hybrid_app_go/application/command"
 	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
 	"github.com/abitofhelp/hybrid_app_go/application/port/inbound"
 	"github.com/abitofhelp/hybrid_app_go/internal/version"
 )
@@ -80,6 +83,109 @@ import (
 //   - Returns exit code for shell
 type GreetCommand[UC inbound.GreetPort] struct {
 	useCase UC
+	config  commandConfig
+}
+
+// commandConfig holds optional, non-generic settings for CLI commands.
+type commandConfig struct {
+	writerName string
+	timeout    time.Duration
+	errOut     io.Writer
+	out        io.Writer
+	ctx        context.Context
+}
+
+// parentContext returns the configured parent context, or
+// context.Background().
+func (cfg commandConfig) parentContext() context.Context {
+	if cfg.ctx == nil {
+		return context.Background()
+	}
+	return cfg.ctx
+}
+
+// requestContext returns the context for one use case call: the parent
+// context, bounded by the timeout if one is set.
+func (cfg commandConfig) requestContext() (context.Context, context.CancelFunc) {
+	if cfg.timeout > 0 {
+		return context.WithTimeout(cfg.parentContext(), cfg.timeout)
+	}
+	return context.WithCancel(cfg.parentContext())
+}
+
+// errorOutput returns the configured error writer, or os.Stderr.
+func (cfg commandConfig) errorOutput() io.Writer {
+	if cfg.errOut == nil {
+		return os.Stderr
+	}
+	return cfg.errOut
+}
+
+// output returns the configured standard writer, or os.Stdout.
+func (cfg commandConfig) output() io.Writer {
+	if cfg.out == nil {
+		return os.Stdout
+	}
+	return cfg.out
+}
+
+// CommandOption configures optional settings on a CLI command.
+type CommandOption func(*commandConfig)
+
+// WithWriterName records a description of the writer adapter wired by
+// bootstrap (e.g., "console (stdout)"), shown in --verbose diagnostics.
+// Presentation cannot inspect infrastructure, so bootstrap supplies it.
+func WithWriterName(name string) CommandOption {
+	return func(cfg *commandConfig) {
+		cfg.writerName = name
+	}
+}
+
+// WithTimeout bounds each use case call by d; 0 (the default) means no
+// timeout. On expiry the use case sees a cancelled context.
+func WithTimeout(d time.Duration) CommandOption {
+	return func(cfg *commandConfig) {
+		cfg.timeout = d
+	}
+}
+
+// WithContext makes ctx the parent of each use case call, so an embedder
+// can cancel a run (or set a deadline) from outside. A nil ctx (the
+// default) means context.Background(). WithTimeout still applies on top.
+func WithContext(ctx context.Context) CommandOption {
+	return func(cfg *commandConfig) {
+		cfg.ctx = ctx
+	}
+}
+
+// WithErrorOutput sends everything Run would print to stderr (usage,
+// error reports, --verbose diagnostics, --dry-run preview) to w instead,
+// so tests can assert on it without redirecting the process.
+// A nil w (the default) means os.Stderr at the time Run is called.
+func WithErrorOutput(w io.Writer) CommandOption {
+	return func(cfg *commandConfig) {
+		cfg.errOut = w
+	}
+}
+
+// WithOutput sends listings that commands print to stdout (e.g., the
+// locales table) to w instead. A nil w (the default) means os.Stdout at the
+// time Run is called. Greetings themselves go through the writer port.
+func WithOutput(w io.Writer) CommandOption {
+	return func(cfg *commandConfig) {
+		cfg.out = w
+	}
+}
+
+// ErrorOutput returns the error writer opts configure, or os.Stderr, so a
+// dispatcher that prints before any command runs (e.g., the command list)
+// reports where the commands would.
+func ErrorOutput(opts ...CommandOption) io.Writer {
+	var cfg commandConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg.errorOutput()
 }
 
 // NewGreetCommand creates a new GreetCommand with injected use case.
@@ -94,8 +200,12 @@ type GreetCommand[UC inbound.GreetPort] struct {
 // Mapping to Ada:
 //   - Ada: package Greet_Command_Instance is new Presentation.CLI.Command.Greet(Execute_Greet_UseCase => Greet_UC.Execute);
 //   - Go: cmd := NewGreetCommand[*usecase.GreetUseCase[*adapter.ConsoleWriter]](uc)
-func NewGreetCommand[UC inbound.GreetPort](useCase UC) *GreetCommand[UC] {
-	return &GreetCommand[UC]{useCase: useCase}
+func NewGreetCommand[UC inbound.GreetPort](useCase UC, opts ...CommandOption) *GreetCommand[UC] {
+	cfg := commandConfig{writerName: "unknown"}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &GreetCommand[UC]{useCase: useCase, config: cfg}
 }
 
 // Run executes the CLI command logic.
@@ -113,8 +223,15 @@ func NewGreetCommand[UC inbound.GreetPort](useCase UC) *GreetCommand[UC] {
 //   - Compiler knows exact implementation → no vtable lookup
 //   - Equivalent to Ada's generic instantiation with compile-time resolution
 //
-// CLI Usage: greeter <name>
+// CLI Usage: greeter [flags] <name>
 // Example: ./greeter Alice
+// Example: ./greeter --dry-run Alice   (validates; prints "would greet: Alice" to stderr)
+// Example: ./greeter -v Alice          (adds [verbose] name/writer/elapsed lines on stderr)
+// Example: ./greeter -q ""             (prints only "Error: ..." without hint lines)
+// Example: ./greeter --version         (prints version, commit, build date)
+// Example: ./greeter --default-name=stranger   (greets "stranger" when no name is given)
+// Example: ./greeter --count 3 Alice   (writes the greeting three times)
+// Example: ./greeter --case title "bob smith"   (greets "Bob Smith")
 //
 // This is where presentation concerns live:
 //   - CLI argument parsing
@@ -125,62 +242,92 @@ func NewGreetCommand[UC inbound.GreetPort](useCase UC) *GreetCommand[UC] {
 // Contract:
 //   - Pre: args can be any slice (validation happens inside)
 //   - Post: Returns 0 if greeting succeeded
-//   - Post: Returns 1 if validation or infrastructure error occurred
-//   - Post: Displays error message to stderr on failure
+//   - Post: Returns 1 on usage error or infrastructure error
+//   - Post: Returns 2 on validation error (see ExitCodeFor)
+//   - Post: Displays error message to stderr (see WithErrorOutput) on failure
 func (c *GreetCommand[UC]) Run(args []string) int {
-	// Check if user provided exactly one argument (the name)
-	if len(args) != 2 { // args[0] is program name, args[1] is the name
-		// Safely get program name (avoid panic if args is empty)
-		programName := "greeter"
-		if len(args) > 0 {
-			programName = args[0]
+	// Parse flags and require exactly one positional argument (the name)
+	errOut := c.config.errorOutput()
+	opts, err := ParseOptions(args)
+	if err != nil {
+		printUsageError(errOut, opts.ProgramName, err)
+		return ExitFailure
+	}
+	if opts.ShowVersion {
+		fmt.Fprintf(os.Stdout, "%s %s\n", opts.ProgramName, version.Info())
+		return ExitSuccess
+	}
+
+	// Enumerated flags fail fast with a uniform UNSUPPORTED_OPTION
+	// ValidationError; the domain still re-validates the choice
+	if opts.Case != "" {
+		caseName := ParseEnum(opts.Case, caseNames)
+		if caseName.IsError() {
+			return runResult(apperr.Err[model.Unit](caseName.ErrorInfo()), errOut, opts.Quiet)
 		}
-		fmt.Fprintf(os.Stderr, "%s v%s\n", programName, version.Version)
-		fmt.Fprintf(os.Stderr, "Usage: %s <name>\n", programName)
-		fmt.Fprintf(os.Stderr, "Example: %s Alice\n", programName)
-		return 1 // Exit code 1 indicates error
+		opts.Case = caseName.Value()
 	}
 
 	// Extract the name from command-line arguments
-	name := args[1]
+	name := opts.Name
+	if opts.Verbose {
+		fmt.Fprintf(errOut, "[verbose] name: %q\n", name)
+		fmt.Fprintf(errOut, "[verbose] writer: %s\n", c.config.writerName)
+	}
 
 	// Create DTO for crossing presentation -> application boundary
-	cmd := command.NewGreetCommand(name)
+	cmd := command.NewGreetCommand(name).WithTimes(opts.Count).WithCase(opts.Case).
+		WithPunctuation(opts.Punctuation)
+
+	// Cheap boundary pre-check: reject obviously-bad input before invoking
+	// the use case. The domain still applies the full validation rules.
+	if check := cmd.Validate(); check.IsError() {
+		return runResult(check, errOut, opts.Quiet)
+	}
 
 	// Create context for the request
-	// For CLI apps, we use Background context. Future enhancement could
-	// add signal handling for graceful shutdown on Ctrl+C.
-	ctx := context.Background()
+	// For CLI apps, we use Background context unless the embedder supplied
+	// a parent with WithContext (bounded by WithTimeout, if set). Future
+	// enhancement could add signal handling for graceful shutdown on Ctrl+C.
+	ctx, cancel := c.config.requestContext()
+	defer cancel()
 
 	// Call the use case (STATIC DISPATCH)
 	// The useCase.Execute() call is statically dispatched because UC is a
 	// concrete type at instantiation time.
 	// This is the key architectural boundary:
 	// Presentation -> Application (through input port)
+	start := time.Now()
 	result := c.useCase.Execute(ctx, cmd)
-
-	// Handle the result from the use case
-	if result.IsOk() {
-		// Success! Greeting was displayed via console port
-		// Use case already wrote to console, just exit cleanly
-		return 0 // Exit code 0 indicates success
+	if opts.Verbose {
+		fmt.Fprintf(errOut, "[verbose] elapsed: %s\n", time.Since(start))
 	}
 
-	// Use case failed - display error to user
-	domErr := result.ErrorInfo()
-
-	// Display user-friendly error message
-	fmt.Fprintf(os.Stderr, "Error: %s\n", domErr.Message)
+	// Success: the greeting was displayed via the console port (or, in
+	// dry-run mode, validated and discarded by a no-op writer)
+	if result.IsOk() && opts.DryRun {
+		fmt.Fprintf(errOut, "would greet: %s\n", name)
+	}
 
-	// Add detailed error handling based on ErrorKind
-	// Note: We use apperr types here but the error comes through domain layer
-	switch domErr.Kind {
-	case apperr.ValidationError:
-		fmt.Fprintln(os.Stderr, "Please provide a valid name.")
+	// Map to an exit code, displaying any error; distinguishes user
+	// error (2) from system error (1)
+	return runResult(result, errOut, opts.Quiet)
+}
 
-	case apperr.InfrastructureError:
-		fmt.Fprintln(os.Stderr, "A system error occurred.")
+// printUsageError displays why the arguments were rejected, if there is a
+// specific reason, followed by the usage.
+func printUsageError(w io.Writer, programName string, err error) {
+	if reason := usageReason(err); reason != "" {
+		fmt.Fprintf(w, "Error: %s\n", reason)
 	}
+	printUsage(w, programName)
+}
 
-	return 1 // Exit code 1 indicates error
+// printUsage displays version, usage, and flag help on w.
+func printUsage(w io.Writer, programName string) {
+	fmt.Fprintf(w, "%s %s\n", programName, version.Info())
+	fmt.Fprintf(w, "Usage: %s [flags] <name>\n", programName)
+	fmt.Fprintf(w, "Example: %s Alice\n", programName)
+	fmt.Fprintln(w, "Flags:")
+	printFlagDefaults(w, programName)
 }
@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: command
+// Description: CLI command for batch greeting with partial success
+
+package command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/abitofhelp/hybrid_app_go/application/command"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	"github.com/abitofhelp/hybrid_app_go/application/port/inbound"
+)
+
+// GreetAllCommand is a CLI command handler that greets every name given on
+// the command line, skipping invalid ones instead of failing the batch.
+//
+// Static Dispatch:
+//   - Generic over GreetAllPort, exactly like GreetCommand over GreetPort
+type GreetAllCommand[UC inbound.GreetAllPort] struct {
+	useCase UC
+	config  commandConfig
+}
+
+// NewGreetAllCommand creates a new GreetAllCommand with injected use case.
+// It accepts the same options as NewGreetCommand; WithWriterName is unused.
+func NewGreetAllCommand[UC inbound.GreetAllPort](useCase UC, opts ...CommandOption) *GreetAllCommand[UC] {
+	cfg := commandConfig{writerName: "unknown"}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &GreetAllCommand[UC]{useCase: useCase, config: cfg}
+}
+
+// Run greets the names in args[1:] as a batch and reports a summary on
+// stderr. Flags (--max-output-bytes, wired by bootstrap) precede the names.
+//
+// CLI Usage: greeter greet-all [flags] <name>...
+// Example: ./greeter greet-all Alice "" Bob
+//
+//	stdout: Hello, Alice!
+//	        Hello, Bob!
+//	stderr: Greeted 2 of 3 names; skipped 1:
+//	          names[1]: Person name cannot be empty
+//
+// Contract:
+//   - Post: Returns 1 with usage on stderr if no names are given or a
+//     flag is invalid
+//   - Post: Otherwise returns ExitCodeForSummary: 0 if every name was
+//     greeted, 2 if none were, 3 on partial success
+//   - Post: Returns ExitCodeFor(err) if the use case fails outright
+//     (batch too large, write failure, cancellation)
+//   - Post: The summary and any errors go to stderr (see WithErrorOutput)
+func (c *GreetAllCommand[UC]) Run(args []string) int {
+	errOut := c.config.errorOutput()
+	opts, err := ParseBatchOptions(args)
+	if err != nil {
+		if reason := usageReason(err); reason != "" {
+			fmt.Fprintf(errOut, "Error: %s\n", reason)
+		}
+		printBatchUsage(errOut, opts.ProgramName)
+		return ExitFailure
+	}
+
+	ctx, cancel := c.config.requestContext()
+	defer cancel()
+
+	result := c.useCase.ExecutePartial(ctx, command.NewGreetAllCommand(opts.Names))
+	if result.IsError() {
+		reportError(errOut, result.ErrorInfo(), false)
+		return ExitCodeFor(result.ErrorInfo())
+	}
+
+	summary := result.Value()
+	printSummary(errOut, summary)
+	return ExitCodeForSummary(summary)
+}
+
+// printBatchUsage displays greet-all usage and flag help on w.
+func printBatchUsage(w io.Writer, programName string) {
+	fmt.Fprintf(w, "Usage: %s [flags] <name>...\n", programName)
+	fmt.Fprintln(w, "Flags:")
+	fs := newBatchFlagSet(&BatchOptions{ProgramName: programName})
+	fs.SetOutput(w)
+	fs.PrintDefaults()
+}
+
+// printSummary writes the batch summary, one indented line per skipped name.
+func printSummary(w io.Writer, s model.GreetSummary) {
+	total := s.Greeted + s.Skipped
+	if s.Skipped == 0 {
+		fmt.Fprintf(w, "Greeted %d of %d names\n", s.Greeted, total)
+		return
+	}
+	fmt.Fprintf(w, "Greeted %d of %d names; skipped %d:\n", s.Greeted, total, s.Skipped)
+	for _, reason := range s.Errors {
+		fmt.Fprintf(w, "  %s\n", reason)
+	}
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package command_test
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	clicmd "github.com/abitofhelp/hybrid_app_go/presentation/adapter/cli/command"
+)
+
+func TestGreetAllCommandRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		result     apperr.Result[model.GreetSummary]
+		wantCode   int
+		wantErrOut string
+	}{
+		{
+			name:       "all success",
+			result:     apperr.Ok(model.GreetSummary{Greeted: 3}),
+			wantCode:   clicmd.ExitSuccess,
+			wantErrOut: "Greeted 3 of 3 names\n",
+		},
+		{
+			name: "all failure",
+			result: apperr.Ok(model.GreetSummary{Skipped: 2, Errors: []string{
+				"names[0]: Person name cannot be empty",
+				"names[1]: Person name cannot be empty",
+			}}),
+			wantCode: clicmd.ExitInvalidInput,
+			wantErrOut: "Greeted 0 of 2 names; skipped 2:\n" +
+				"  names[0]: Person name cannot be empty\n" +
+				"  names[1]: Person name cannot be empty\n",
+		},
+		{
+			name: "mixed",
+			result: apperr.Ok(model.GreetSummary{Greeted: 2, Skipped: 1, Errors: []string{
+				"names[1]: Person name cannot be empty",
+			}}),
+			wantCode: clicmd.ExitPartial,
+			wantErrOut: "Greeted 2 of 3 names; skipped 1:\n" +
+				"  names[1]: Person name cannot be empty\n",
+		},
+		{
+			name:       "use case fails outright",
+			result:     apperr.Err[model.GreetSummary](apperr.NewInfrastructureError("disk full")),
+			wantCode:   clicmd.ExitFailure,
+			wantErrOut: "Error: disk full\nA system error occurred.\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var errOut bytes.Buffer
+			uc := &stubBatchUseCase{result: tc.result}
+			code := clicmd.NewGreetAllCommand(uc, clicmd.WithErrorOutput(&errOut)).
+				Run([]string{"greeter greet-all", "Alice", "", "Bob"})
+
+			if code != tc.wantCode {
+				t.Errorf("Run() = %d, want %d", code, tc.wantCode)
+			}
+			if errOut.String() != tc.wantErrOut {
+				t.Errorf("errOut = %q, want %q", errOut.String(), tc.wantErrOut)
+			}
+			if len(uc.received) != 1 || !reflect.DeepEqual(uc.received[0].GetNames(), []string{"Alice", "", "Bob"}) {
+				t.Errorf("use case received %+v, want one batch of [Alice, \"\", Bob]", uc.received)
+			}
+		})
+	}
+}
+
+func TestGreetAllCommandRun_NoNames(t *testing.T) {
+	var errOut bytes.Buffer
+	uc := &stubBatchUseCase{}
+	code := clicmd.NewGreetAllCommand(uc, clicmd.WithErrorOutput(&errOut)).Run([]string{"greeter greet-all"})
+
+	if code != clicmd.ExitFailure {
+		t.Errorf("Run() = %d, want %d", code, clicmd.ExitFailure)
+	}
+	if want := "Usage: greeter greet-all [flags] <name>...\n"; !strings.HasPrefix(errOut.String(), want) {
+		t.Errorf("errOut = %q, want prefix %q", errOut.String(), want)
+	}
+	if !strings.Contains(errOut.String(), "-max-output-bytes") {
+		t.Errorf("errOut = %q, want flag help", errOut.String())
+	}
+	if len(uc.received) != 0 {
+		t.Errorf("use case invoked without names")
+	}
+
+	errOut.Reset()
+	code = clicmd.NewGreetAllCommand(uc, clicmd.WithErrorOutput(&errOut)).
+		Run([]string{"greeter greet-all", "--max-output-bytes=-5", "Alice"})
+	if code != clicmd.ExitFailure {
+		t.Errorf("Run(negative budget) = %d, want %d", code, clicmd.ExitFailure)
+	}
+	if want := "Error: --max-output-bytes must not be negative\nUsage: "; !strings.HasPrefix(errOut.String(), want) {
+		t.Errorf("errOut = %q, want prefix %q", errOut.String(), want)
+	}
+}
@@ -0,0 +1,301 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package command_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	clicmd "github.com/abitofhelp/hybrid_app_go/presentation/adapter/cli/command"
+)
+
+func TestGreetCommandRun_Verbosity(t *testing.T) {
+	tests := []struct {
+		name     string
+		uc       *stubUseCase
+		args     []string
+		present  []string
+		absent   []string
+		wantCode int
+	}{
+		{"normal success has no diagnostics", okUseCase(),
+			[]string{"greeter", "Alice"},
+			nil, []string{"[verbose]"}, 0},
+		{"verbose success shows name, writer, timing", okUseCase(),
+			[]string{"greeter", "-v", "Alice"},
+			[]string{`[verbose] name: "Alice"`, "[verbose] writer: console (test)", "[verbose] elapsed: "},
+			nil, 0},
+		{"normal error shows hint", errUseCase(apperr.NewValidationError("too long")),
+			[]string{"greeter", "Alice"},
+			[]string{"Error: too long", "Please provide a valid name."}, []string{"[verbose]"}, 2},
+		{"quiet error suppresses hint", errUseCase(apperr.NewValidationError("too long")),
+			[]string{"greeter", "--quiet", "Alice"},
+			[]string{"Error: too long"}, []string{"Please provide a valid name.", "[verbose]"}, 2},
+		{"quiet infrastructure error suppresses hint", errUseCase(apperr.NewInfrastructureError("disk full")),
+			[]string{"greeter", "-q", "Alice"},
+			[]string{"Error: disk full"}, []string{"A system error occurred."}, 1},
+		{"verbose and quiet together is a usage error", okUseCase(),
+			[]string{"greeter", "-v", "-q", "Alice"},
+			[]string{"Error: --verbose and --quiet are mutually exclusive\n", "Usage:"}, nil, 1},
+		{"negative output budget reports the reason", okUseCase(),
+			[]string{"greeter", "--max-output-bytes=-1", "Alice"},
+			[]string{"Error: --max-output-bytes must not be negative\n", "Usage:"}, nil, 1},
+		{"interactive with a name reports the reason", okUseCase(),
+			[]string{"greeter", "--interactive", "Alice"},
+			[]string{"Error: --interactive reads names from stdin, not arguments\n", "Usage:"}, nil, 1},
+		{"unknown flag reports the flag", okUseCase(),
+			[]string{"greeter", "--nope", "Alice"},
+			[]string{"Error: flag provided but not defined: -nope\n", "Usage:"}, nil, 1},
+		{"missing name shows usage only", okUseCase(),
+			[]string{"greeter"},
+			[]string{"Usage:"}, []string{"Error:"}, 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var errOut bytes.Buffer
+			cmd := clicmd.NewGreetCommand(tc.uc,
+				clicmd.WithWriterName("console (test)"), clicmd.WithErrorOutput(&errOut))
+			code := cmd.Run(tc.args)
+			stderr := errOut.String()
+
+			if code != tc.wantCode {
+				t.Errorf("exit code = %d, want %d", code, tc.wantCode)
+			}
+			for _, want := range tc.present {
+				if !strings.Contains(stderr, want) {
+					t.Errorf("stderr missing %q; got:\n%s", want, stderr)
+				}
+			}
+			for _, unwanted := range tc.absent {
+				if strings.Contains(stderr, unwanted) {
+					t.Errorf("stderr unexpectedly contains %q; got:\n%s", unwanted, stderr)
+				}
+			}
+		})
+	}
+}
+
+func TestGreetCommandRun_Version(t *testing.T) {
+	uc := okUseCase()
+	cmd := clicmd.NewGreetCommand(uc)
+
+	var code int
+	stdout := captureStdout(t, func() { code = cmd.Run([]string{"greeter", "--version"}) })
+
+	if code != clicmd.ExitSuccess {
+		t.Errorf("exit code = %d, want %d", code, clicmd.ExitSuccess)
+	}
+	for _, want := range []string{"greeter v", "commit ", "built "} {
+		if !strings.Contains(stdout, want) {
+			t.Errorf("stdout missing %q; got: %q", want, stdout)
+		}
+	}
+	if len(uc.received) != 0 {
+		t.Errorf("use case invoked for --version")
+	}
+}
+
+func TestGreetCommandRun_Timeout(t *testing.T) {
+	uc := okUseCase()
+	clicmd.NewGreetCommand(uc, clicmd.WithErrorOutput(io.Discard)).Run([]string{"greeter", "Alice"})
+	if _, ok := uc.contexts[0].Deadline(); ok {
+		t.Errorf("default context has a deadline, want none")
+	}
+
+	uc = okUseCase()
+	before := time.Now()
+	clicmd.NewGreetCommand(uc, clicmd.WithTimeout(time.Minute), clicmd.WithErrorOutput(io.Discard)).
+		Run([]string{"greeter", "Alice"})
+	deadline, ok := uc.contexts[0].Deadline()
+	if !ok {
+		t.Fatalf("WithTimeout context has no deadline")
+	}
+	if d := deadline.Sub(before); d <= 0 || d > time.Minute+time.Second {
+		t.Errorf("deadline %v after start, want about 1m", d)
+	}
+}
+
+func TestGreetCommandRun_Context(t *testing.T) {
+	type key struct{}
+	parent, cancel := context.WithCancel(context.WithValue(context.Background(), key{}, "embedder"))
+	defer cancel()
+
+	uc := okUseCase()
+	clicmd.NewGreetCommand(uc, clicmd.WithContext(parent), clicmd.WithErrorOutput(io.Discard)).
+		Run([]string{"greeter", "Alice"})
+	if uc.contexts[0].Value(key{}) != "embedder" {
+		t.Errorf("use case context does not derive from WithContext parent")
+	}
+	if uc.ctxErrs[0] != nil {
+		t.Errorf("use case context already done: %v", uc.ctxErrs[0])
+	}
+
+	cancel()
+	uc = okUseCase()
+	clicmd.NewGreetCommand(uc, clicmd.WithContext(parent), clicmd.WithErrorOutput(io.Discard)).
+		Run([]string{"greeter", "Alice"})
+	if uc.ctxErrs[0] == nil {
+		t.Errorf("cancelled parent did not reach the use case context")
+	}
+
+	uc = okUseCase()
+	clicmd.NewGreetCommand(uc, clicmd.WithContext(parent), clicmd.WithTimeout(time.Minute),
+		clicmd.WithErrorOutput(io.Discard)).Run([]string{"greeter", "Alice"})
+	if uc.contexts[0].Value(key{}) != "embedder" {
+		t.Errorf("WithTimeout context does not derive from WithContext parent")
+	}
+	if _, ok := uc.contexts[0].Deadline(); !ok {
+		t.Errorf("WithTimeout not applied on top of WithContext parent")
+	}
+}
+
+func TestGreetCommandRun_DefaultName(t *testing.T) {
+	uc := okUseCase()
+	var errOut bytes.Buffer
+	code := clicmd.NewGreetCommand(uc, clicmd.WithErrorOutput(&errOut)).
+		Run([]string{"greeter", "--default-name=stranger"})
+	if code != clicmd.ExitSuccess {
+		t.Errorf("default name: exit code = %d, want %d", code, clicmd.ExitSuccess)
+	}
+	if len(uc.received) != 1 || uc.received[0].GetName() != "stranger" {
+		t.Errorf("default name: use case received %v, want [stranger]", uc.received)
+	}
+
+	uc = okUseCase()
+	errOut.Reset()
+	code = clicmd.NewGreetCommand(uc, clicmd.WithErrorOutput(&errOut)).Run([]string{"greeter"})
+	if code != clicmd.ExitFailure {
+		t.Errorf("no default: exit code = %d, want %d", code, clicmd.ExitFailure)
+	}
+	if !strings.Contains(errOut.String(), "Usage: greeter [flags] <name>") {
+		t.Errorf("no default: errOut missing usage; got:\n%s", errOut.String())
+	}
+	if len(uc.received) != 0 {
+		t.Errorf("no default: use case invoked with %v", uc.received)
+	}
+}
+
+func TestGreetCommandRun_ErrorOutputLines(t *testing.T) {
+	tests := []struct {
+		name    string
+		uc      *stubUseCase
+		args    []string
+		want    []string
+		wantLen int
+	}{
+		{"usage", okUseCase(), []string{"greeter", "Alice", "Bob"},
+			[]string{"Usage: greeter [flags] <name>", "Example: greeter Alice", "Flags:"}, 0},
+		{"validation error", errUseCase(apperr.NewValidationError("too long")), []string{"greeter", "Alice"},
+			[]string{"Error: too long", "Please provide a valid name."}, 2},
+		{"dry run preview", okUseCase(), []string{"greeter", "--dry-run", "Alice"},
+			[]string{"would greet: Alice"}, 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var errOut bytes.Buffer
+			clicmd.NewGreetCommand(tc.uc, clicmd.WithErrorOutput(&errOut)).Run(tc.args)
+			lines := strings.Split(strings.TrimSuffix(errOut.String(), "\n"), "\n")
+			if tc.wantLen > 0 && len(lines) != tc.wantLen {
+				t.Errorf("errOut has %d lines, want %d; got:\n%s", len(lines), tc.wantLen, errOut.String())
+			}
+			for _, want := range tc.want {
+				found := false
+				for _, line := range lines {
+					found = found || line == want
+				}
+				if !found {
+					t.Errorf("errOut missing line %q; got:\n%s", want, errOut.String())
+				}
+			}
+		})
+	}
+}
+
+func TestGreetCommandRun_Count(t *testing.T) {
+	tests := []struct {
+		name      string
+		args      []string
+		wantTimes int
+	}{
+		{"default", []string{"greeter", "Alice"}, 1},
+		{"count 3", []string{"greeter", "--count", "3", "Alice"}, 3},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := okUseCase()
+			clicmd.NewGreetCommand(uc, clicmd.WithErrorOutput(io.Discard)).Run(tc.args)
+			if len(uc.received) != 1 || uc.received[0].GetTimes() != tc.wantTimes {
+				t.Errorf("use case received %+v, want one command with Times=%d", uc.received, tc.wantTimes)
+			}
+		})
+	}
+}
+
+func TestGreetCommandRun_Punctuation(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"default", []string{"greeter", "Alice"}, "!"},
+		{"period", []string{"greeter", "--punctuation", ".", "Alice"}, "."},
+		{"none", []string{"greeter", "--punctuation=", "Alice"}, ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := okUseCase()
+			clicmd.NewGreetCommand(uc, clicmd.WithErrorOutput(io.Discard)).Run(tc.args)
+			if len(uc.received) != 1 || uc.received[0].GetPunctuation() != tc.want {
+				t.Errorf("use case received %+v, want one command with Punctuation=%q", uc.received, tc.want)
+			}
+		})
+	}
+}
+
+func TestGreetCommandRun_UnsupportedCase(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		wantCode int
+		wantCase string
+		wantErr  string
+	}{
+		{"valid is canonicalized", []string{"greeter", "--case", " Title ", "bob"}, clicmd.ExitSuccess, "title", ""},
+		{"unset passes through", []string{"greeter", "bob"}, clicmd.ExitSuccess, "", ""},
+		{"invalid lists choices", []string{"greeter", "-q", "--case=shouty", "bob"}, clicmd.ExitInvalidInput, "",
+			"Error: UNSUPPORTED_OPTION: unsupported value \"shouty\" (valid: none, upper, lower, title)\n"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			uc := okUseCase()
+			var errOut bytes.Buffer
+			code := clicmd.NewGreetCommand(uc, clicmd.WithErrorOutput(&errOut)).Run(tc.args)
+			if code != tc.wantCode {
+				t.Errorf("Run(%q) = %d, want %d", tc.args, code, tc.wantCode)
+			}
+			if errOut.String() != tc.wantErr {
+				t.Errorf("errOut = %q, want %q", errOut.String(), tc.wantErr)
+			}
+			if tc.wantErr != "" {
+				if len(uc.received) != 0 {
+					t.Errorf("use case received %+v, want no call", uc.received)
+				}
+				return
+			}
+			if len(uc.received) != 1 || uc.received[0].GetCase() != tc.wantCase {
+				t.Errorf("use case received %+v, want one command with Case=%q", uc.received, tc.wantCase)
+			}
+		})
+	}
+}
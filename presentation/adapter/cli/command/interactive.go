@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: command
+// Description: CLI command for interactive greeting from stdin
+
+package command
+
+import (
+	"fmt"
+
+	"github.com/abitofhelp/hybrid_app_go/application/port/inbound"
+)
+
+// InteractiveCommand is a CLI command handler for --interactive mode:
+// names are read from stdin and greeted as they are entered.
+//
+// Static Dispatch:
+//   - Generic over GreetInteractivePort, exactly like GreetCommand over GreetPort
+type InteractiveCommand[UC inbound.GreetInteractivePort] struct {
+	useCase UC
+	config  commandConfig
+}
+
+// NewInteractiveCommand creates a new InteractiveCommand with injected
+// use case. Bootstrap supplies the stdin reader to the use case.
+func NewInteractiveCommand[UC inbound.GreetInteractivePort](useCase UC, opts ...CommandOption) *InteractiveCommand[UC] {
+	cfg := commandConfig{writerName: "unknown"}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &InteractiveCommand[UC]{useCase: useCase, config: cfg}
+}
+
+// Run prompts on stderr, greets names until EOF or a blank line, and
+// reports a summary on stderr.
+//
+// CLI Usage: greeter --interactive
+// Example: printf 'Alice\nBob\n\n' | ./greeter --interactive
+//
+//	stdout: Hello, Alice!
+//	        Hello, Bob!
+//	stderr: Enter names, one per line (blank line or EOF to finish):
+//	        Greeted 2 of 2 names
+//
+// Contract:
+//   - Post: Returns 1 with usage on stderr if args do not parse
+//   - Post: Otherwise returns ExitCodeForSummary (0 also when no names
+//     were entered), or ExitCodeFor(err) if a read or write failed
+//   - Post: --quiet suppresses the prompt and the summary
+func (c *InteractiveCommand[UC]) Run(args []string) int {
+	errOut := c.config.errorOutput()
+	opts, err := ParseOptions(args)
+	if err != nil {
+		printUsageError(errOut, opts.ProgramName, err)
+		return ExitFailure
+	}
+	if !opts.Interactive {
+		printUsage(errOut, opts.ProgramName)
+		return ExitFailure
+	}
+	if !opts.Quiet {
+		fmt.Fprintln(errOut, "Enter names, one per line (blank line or EOF to finish):")
+	}
+
+	ctx, cancel := c.config.requestContext()
+	defer cancel()
+
+	result := c.useCase.ExecuteInteractive(ctx)
+	if result.IsError() {
+		reportError(errOut, result.ErrorInfo(), opts.Quiet)
+		return ExitCodeFor(result.ErrorInfo())
+	}
+
+	summary := result.Value()
+	if !opts.Quiet {
+		printSummary(errOut, summary)
+	}
+	return ExitCodeForSummary(summary)
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package command_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	clicmd "github.com/abitofhelp/hybrid_app_go/presentation/adapter/cli/command"
+)
+
+func TestInteractiveCommandRun(t *testing.T) {
+	const prompt = "Enter names, one per line (blank line or EOF to finish):\n"
+
+	tests := []struct {
+		name       string
+		args       []string
+		result     apperr.Result[model.GreetSummary]
+		wantCode   int
+		wantErrOut string
+		wantCalls  int
+	}{
+		{
+			name:       "names greeted",
+			args:       []string{"greeter", "--interactive"},
+			result:     apperr.Ok(model.GreetSummary{Greeted: 2}),
+			wantCode:   clicmd.ExitSuccess,
+			wantErrOut: prompt + "Greeted 2 of 2 names\n",
+			wantCalls:  1,
+		},
+		{
+			name:       "no names entered",
+			args:       []string{"greeter", "--interactive"},
+			result:     apperr.Ok(model.GreetSummary{}),
+			wantCode:   clicmd.ExitSuccess,
+			wantErrOut: prompt + "Greeted 0 of 0 names\n",
+			wantCalls:  1,
+		},
+		{
+			name: "some skipped",
+			args: []string{"greeter", "--interactive"},
+			result: apperr.Ok(model.GreetSummary{Greeted: 1, Skipped: 1, Errors: []string{
+				"line 2: Person name cannot be empty",
+			}}),
+			wantCode: clicmd.ExitPartial,
+			wantErrOut: prompt + "Greeted 1 of 2 names; skipped 1:\n" +
+				"  line 2: Person name cannot be empty\n",
+			wantCalls: 1,
+		},
+		{
+			name:       "quiet",
+			args:       []string{"greeter", "--interactive", "-q"},
+			result:     apperr.Ok(model.GreetSummary{Greeted: 1}),
+			wantCode:   clicmd.ExitSuccess,
+			wantErrOut: "",
+			wantCalls:  1,
+		},
+		{
+			name:       "read failure",
+			args:       []string{"greeter", "--interactive"},
+			result:     apperr.Err[model.GreetSummary](apperr.NewInfrastructureError("read failed")),
+			wantCode:   clicmd.ExitFailure,
+			wantErrOut: prompt + "Error: read failed\nA system error occurred.\n",
+			wantCalls:  1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var errOut bytes.Buffer
+			uc := &stubInteractiveUseCase{result: tc.result}
+			code := clicmd.NewInteractiveCommand(uc, clicmd.WithErrorOutput(&errOut)).Run(tc.args)
+
+			if code != tc.wantCode {
+				t.Errorf("Run() = %d, want %d", code, tc.wantCode)
+			}
+			if errOut.String() != tc.wantErrOut {
+				t.Errorf("errOut = %q, want %q", errOut.String(), tc.wantErrOut)
+			}
+			if uc.calls != tc.wantCalls {
+				t.Errorf("use case calls = %d, want %d", uc.calls, tc.wantCalls)
+			}
+		})
+	}
+}
+
+func TestInteractiveCommandRun_Usage(t *testing.T) {
+	for _, tc := range []struct {
+		args       []string
+		wantReason string
+	}{
+		{[]string{"greeter", "--interactive", "Alice"}, "Error: --interactive reads names from stdin, not arguments\n"},
+		{[]string{"greeter", "--interactive", "--dry-run"}, "Error: --interactive cannot be combined with --dry-run\n"},
+		{[]string{"greeter", "Alice"}, ""},
+	} {
+		args := tc.args
+		var errOut bytes.Buffer
+		uc := &stubInteractiveUseCase{}
+		code := clicmd.NewInteractiveCommand(uc, clicmd.WithErrorOutput(&errOut)).Run(args)
+
+		if code != clicmd.ExitFailure {
+			t.Errorf("Run(%q) = %d, want %d", args, code, clicmd.ExitFailure)
+		}
+		if !strings.HasPrefix(errOut.String(), tc.wantReason) {
+			t.Errorf("Run(%q) errOut = %q, want prefix %q", args, errOut.String(), tc.wantReason)
+		}
+		if tc.wantReason == "" && strings.Contains(errOut.String(), "Error:") {
+			t.Errorf("Run(%q) errOut = %q, want usage only", args, errOut.String())
+		}
+		if !strings.Contains(errOut.String(), "Usage: greeter [flags] <name>") {
+			t.Errorf("Run(%q) errOut = %q, want usage", args, errOut.String())
+		}
+		if uc.calls != 0 {
+			t.Errorf("Run(%q) invoked the use case", args)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: command
+// Description: CLI command listing the supported greeting locales
+
+package command
+
+import (
+	"fmt"
+
+	"github.com/abitofhelp/hybrid_app_go/application/command"
+	"github.com/abitofhelp/hybrid_app_go/application/port/inbound"
+)
+
+// LocalesCommand is a CLI command handler that prints each supported
+// locale code with a sample greeting.
+//
+// Static Dispatch:
+//   - Generic over LocalesPort, exactly like GreetCommand over GreetPort
+type LocalesCommand[UC inbound.LocalesPort] struct {
+	useCase UC
+	config  commandConfig
+}
+
+// NewLocalesCommand creates a new LocalesCommand with injected use case.
+// It accepts the same options as NewGreetCommand; WithOutput redirects
+// the listing.
+func NewLocalesCommand[UC inbound.LocalesPort](useCase UC, opts ...CommandOption) *LocalesCommand[UC] {
+	cfg := commandConfig{writerName: "unknown"}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return &LocalesCommand[UC]{useCase: useCase, config: cfg}
+}
+
+// Run prints the supported locales, one per line, to stdout.
+//
+// CLI Usage: greeter locales
+// Example: ./greeter locales
+//
+//	stdout: en  Hello, Alice!
+//	        es  ¡Hola, Alice!
+//	        fr  Bonjour, Alice !
+//
+// Contract:
+//   - Post: Returns 0 after printing every locale (see WithOutput)
+//   - Post: Returns 1 with usage on stderr if any arguments are given
+//   - Post: Returns ExitCodeFor(err) if the use case fails
+func (c *LocalesCommand[UC]) Run(args []string) int {
+	errOut := c.config.errorOutput()
+	programName := "greeter locales"
+	if len(args) > 0 {
+		programName = args[0]
+	}
+	if len(args) > 1 {
+		fmt.Fprintf(errOut, "Usage: %s\n", programName)
+		return ExitFailure
+	}
+
+	ctx, cancel := c.config.requestContext()
+	defer cancel()
+
+	result := c.useCase.Execute(ctx, command.NewLocalesCommand())
+	if result.IsError() {
+		reportError(errOut, result.ErrorInfo(), false)
+		return ExitCodeFor(result.ErrorInfo())
+	}
+
+	out := c.config.output()
+	for _, info := range result.Value() {
+		fmt.Fprintf(out, "%-3s %s\n", info.Code, info.Sample)
+	}
+	return ExitSuccess
+}
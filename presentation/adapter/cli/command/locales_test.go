@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package command_test
+
+import (
+	"bytes"
+	"testing"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	clicmd "github.com/abitofhelp/hybrid_app_go/presentation/adapter/cli/command"
+)
+
+func TestLocalesCommandRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		result     apperr.Result[[]model.LocaleInfo]
+		wantCode   int
+		wantOut    string
+		wantErrOut string
+		wantCalls  int
+	}{
+		{
+			name: "lists locales",
+			args: []string{"greeter locales"},
+			result: apperr.Ok([]model.LocaleInfo{
+				{Code: "en", Sample: "Hello, Alice!"},
+				{Code: "es", Sample: "¡Hola, Alice!"},
+				{Code: "fr", Sample: "Bonjour, Alice !"},
+			}),
+			wantCode:  clicmd.ExitSuccess,
+			wantOut:   "en  Hello, Alice!\nes  ¡Hola, Alice!\nfr  Bonjour, Alice !\n",
+			wantCalls: 1,
+		},
+		{
+			name:       "unexpected argument",
+			args:       []string{"greeter locales", "Alice"},
+			wantCode:   clicmd.ExitFailure,
+			wantErrOut: "Usage: greeter locales\n",
+		},
+		{
+			name:       "use case failure",
+			args:       []string{"greeter locales"},
+			result:     apperr.Err[[]model.LocaleInfo](apperr.NewInfrastructureError("list locales cancelled: context canceled")),
+			wantCode:   clicmd.ExitFailure,
+			wantErrOut: "Error: list locales cancelled: context canceled\n",
+			wantCalls:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := &stubLocalesUseCase{result: tt.result}
+			var out, errOut bytes.Buffer
+			cmd := clicmd.NewLocalesCommand(uc, clicmd.WithOutput(&out), clicmd.WithErrorOutput(&errOut))
+
+			if got := cmd.Run(tt.args); got != tt.wantCode {
+				t.Errorf("Run() = %d, want %d", got, tt.wantCode)
+			}
+			if out.String() != tt.wantOut {
+				t.Errorf("stdout = %q, want %q", out.String(), tt.wantOut)
+			}
+			if tt.wantErrOut != "" && !bytes.HasPrefix(errOut.Bytes(), []byte(tt.wantErrOut)) {
+				t.Errorf("stderr = %q, want prefix %q", errOut.String(), tt.wantErrOut)
+			}
+			if uc.calls != tt.wantCalls {
+				t.Errorf("use case calls = %d, want %d", uc.calls, tt.wantCalls)
+			}
+		})
+	}
+}
@@ -0,0 +1,275 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: command
+// Description: Command-line flag parsing for the greet command
+
+package command
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/abitofhelp/hybrid_app_go/application/command"
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+)
+
+// Options holds the parsed command-line flags and positional name.
+//
+// Bootstrap also parses Options (via ParseOptions) to decide how to wire
+// infrastructure, e.g., routing --dry-run through a no-op writer or
+// wrapping the writer for --prefix/--suffix.
+type Options struct {
+	// ProgramName is args[0] (or "greeter" if args is empty).
+	ProgramName string
+
+	// Name is the single positional argument (the person to greet).
+	Name string
+
+	// DryRun validates the name without writing the greeting.
+	DryRun bool
+
+	// Verbose prints diagnostics (resolved name, writer, timing) to stderr.
+	Verbose bool
+
+	// Quiet suppresses human-oriented hint lines on stderr.
+	Quiet bool
+
+	// Prefix and Suffix are written verbatim around the greeting.
+	// Decoration is wired by bootstrap; the domain greeting is unchanged.
+	Prefix string
+	Suffix string
+
+	// Format, Output, and Timeout override the embedder/environment
+	// configuration when set (empty/zero means "not given"). Bootstrap
+	// validates and applies them; see cli.RunWith.
+	Format  string
+	Output  string
+	Timeout time.Duration
+
+	// ShowVersion prints build information and exits; no name is required.
+	ShowVersion bool
+
+	// DefaultName is greeted when no positional name is given (e.g.,
+	// "stranger"). Empty means a missing name is a usage error.
+	DefaultName string
+
+	// Count is how many times the greeting is written (default 1).
+	// ParseOptions rejects values outside 1..command.MaxTimes.
+	Count int
+
+	// Case is the letter case applied to the name (upper, lower, title,
+	// none); empty means none. The use case validates the value.
+	Case string
+
+	// Punctuation ends the greeting (default "!"; empty means none).
+	// The domain validates the value.
+	Punctuation string
+
+	// MaxOutputBytes caps the total bytes written in this invocation,
+	// newlines included; 0 means unlimited. Wired by bootstrap.
+	MaxOutputBytes int64
+
+	// Interactive reads names from stdin, one per line, until EOF or a
+	// blank line, instead of taking a positional name. Wired by bootstrap.
+	Interactive bool
+}
+
+// BatchOptions holds the parsed flags and names for greet-all.
+type BatchOptions struct {
+	// ProgramName is args[0] (or "greeter greet-all" if args is empty).
+	ProgramName string
+
+	// Names are the positional arguments, in order; empty names are kept
+	// so the use case can report them as skipped.
+	Names []string
+
+	// MaxOutputBytes is as in Options.
+	MaxOutputBytes int64
+}
+
+// errUsage reports that the arguments did not match the expected shape.
+var errUsage = errors.New("usage error")
+
+// IsUsageError reports whether err came from ParseOptions or
+// ParseBatchOptions rejecting the shape of the arguments. The command
+// that parses the same arguments reports such errors with its usage text.
+func IsUsageError(err error) bool {
+	return errors.Is(err, errUsage)
+}
+
+// usageReason returns the specific reason wrapped with errUsage (e.g.,
+// "--verbose and --quiet are mutually exclusive"), or "" if err carries
+// none (a missing or extra name, where the usage text says it all).
+func usageReason(err error) string {
+	return strings.TrimPrefix(strings.TrimPrefix(err.Error(), errUsage.Error()), ": ")
+}
+
+// ParseOptions parses command-line arguments (args[0] is the program name).
+//
+// Flags must precede the name: greeter [flags] <name>
+//
+// Contract:
+//   - Post: Returns an error if a flag is unknown/malformed, if --verbose
+//     and --quiet are combined, if --count is outside 1..command.MaxTimes,
+//     or if there is not exactly one positional name argument
+//   - Post: With --version, no positional name is required
+//   - Post: With --interactive, a positional name is an error, as is any
+//     flag not in interactiveFlags (e.g., --dry-run, --prefix, --count)
+//   - Post: With --default-name and no positional name, Name is DefaultName
+//   - Post: ProgramName is always set, even on error
+func ParseOptions(args []string) (Options, error) {
+	opts := Options{ProgramName: "greeter"}
+	if len(args) > 0 {
+		opts.ProgramName = args[0]
+		args = args[1:]
+	}
+
+	fs := newFlagSet(&opts)
+	fs.SetOutput(io.Discard)
+	if err := fs.Parse(args); err != nil {
+		return opts, fmt.Errorf("%w: %v", errUsage, err)
+	}
+	if opts.ShowVersion {
+		return opts, nil
+	}
+	if opts.Verbose && opts.Quiet {
+		return opts, fmt.Errorf("%w: --verbose and --quiet are mutually exclusive", errUsage)
+	}
+	if opts.MaxOutputBytes < 0 {
+		return opts, fmt.Errorf("%w: --max-output-bytes must not be negative", errUsage)
+	}
+	if opts.Count < 1 || opts.Count > command.MaxTimes {
+		return opts, fmt.Errorf("%w: --count must be between 1 and %d, got %d", errUsage, command.MaxTimes, opts.Count)
+	}
+	if opts.Interactive {
+		if fs.NArg() != 0 {
+			return opts, fmt.Errorf("%w: --interactive reads names from stdin, not arguments", errUsage)
+		}
+		if conflict := interactiveConflict(fs); conflict != "" {
+			return opts, fmt.Errorf("%w: --interactive cannot be combined with --%s", errUsage, conflict)
+		}
+		return opts, nil
+	}
+	name := positionalName(fs.Args()).RecoverWith(func(e apperr.ErrorType) apperr.Result[string] {
+		if fs.NArg() == 0 && opts.DefaultName != "" {
+			return apperr.Ok(opts.DefaultName)
+		}
+		return apperr.Err[string](e)
+	})
+	if name.IsError() {
+		return opts, errUsage
+	}
+	opts.Name = name.Value()
+	return opts, nil
+}
+
+// ParseBatchOptions parses greet-all arguments (args[0] is the program
+// name).
+//
+// Flags must precede the names: greeter greet-all [flags] <name>...
+//
+// Contract:
+//   - Post: Returns an error if a flag is unknown/malformed, if
+//     --max-output-bytes is negative, or if no names are given
+//   - Post: ProgramName is always set, even on error
+func ParseBatchOptions(args []string) (BatchOptions, error) {
+	opts := BatchOptions{ProgramName: "greeter greet-all"}
+	if len(args) > 0 {
+		opts.ProgramName = args[0]
+		args = args[1:]
+	}
+
+	fs := newBatchFlagSet(&opts)
+	fs.SetOutput(io.Discard)
+	if err := fs.Parse(args); err != nil {
+		return opts, fmt.Errorf("%w: %v", errUsage, err)
+	}
+	if opts.MaxOutputBytes < 0 {
+		return opts, fmt.Errorf("%w: --max-output-bytes must not be negative", errUsage)
+	}
+	if fs.NArg() == 0 {
+		return opts, errUsage
+	}
+	opts.Names = fs.Args()
+	return opts, nil
+}
+
+// interactiveFlags are the flags honoured by --interactive; the others
+// shape a single greeting or its writer and would be silently ignored.
+var interactiveFlags = map[string]bool{
+	"interactive": true,
+	"verbose":     true,
+	"v":           true,
+	"quiet":       true,
+	"q":           true,
+	"format":      true,
+	"output":      true,
+	"timeout":     true,
+}
+
+// interactiveConflict returns the first flag set on fs (in lexical order)
+// that --interactive does not honour, or "" if there is none.
+func interactiveConflict(fs *flag.FlagSet) string {
+	conflict := ""
+	fs.Visit(func(f *flag.Flag) {
+		if conflict == "" && !interactiveFlags[f.Name] {
+			conflict = f.Name
+		}
+	})
+	return conflict
+}
+
+// positionalName returns the single positional argument, or Err if there
+// is not exactly one.
+func positionalName(args []string) apperr.Result[string] {
+	if len(args) != 1 {
+		return apperr.Err[string](apperr.NewValidationError(
+			fmt.Sprintf("expected exactly one name, got %d", len(args))))
+	}
+	return apperr.Ok(args[0])
+}
+
+// newFlagSet defines every flag on a fresh FlagSet bound to opts.
+// Both parsing and usage output use this, so they never drift apart.
+func newFlagSet(opts *Options) *flag.FlagSet {
+	fs := flag.NewFlagSet(opts.ProgramName, flag.ContinueOnError)
+	fs.BoolVar(&opts.DryRun, "dry-run", false, "validate the name and preview the greeting without writing it")
+	fs.BoolVar(&opts.Verbose, "verbose", false, "print diagnostics (resolved name, writer, timing) to stderr")
+	fs.BoolVar(&opts.Verbose, "v", false, "shorthand for --verbose")
+	fs.BoolVar(&opts.Quiet, "quiet", false, "suppress hint lines; emit only machine-usable messages")
+	fs.BoolVar(&opts.Quiet, "q", false, "shorthand for --quiet")
+	fs.StringVar(&opts.Prefix, "prefix", "", "text written before each greeting (include any spaces)")
+	fs.StringVar(&opts.Suffix, "suffix", "", "text written after each greeting (include any spaces)")
+	fs.StringVar(&opts.Format, "format", "", "output format: text or csv (overrides GREETER_FORMAT)")
+	fs.StringVar(&opts.Output, "output", "", "output target: stdout or stderr (overrides GREETER_OUTPUT)")
+	fs.DurationVar(&opts.Timeout, "timeout", 0, "bound the greet call, e.g. 2s (overrides GREETER_TIMEOUT)")
+	fs.BoolVar(&opts.ShowVersion, "version", false, "print version, commit, and build date, then exit")
+	fs.IntVar(&opts.Count, "count", 1, fmt.Sprintf("write the greeting N times (1-%d)", command.MaxTimes))
+	fs.StringVar(&opts.Case, "case", "", "transform the name: upper, lower, title, or none")
+	fs.StringVar(&opts.Punctuation, "punctuation", "!", `end the greeting with this punctuation, e.g. "." or "" for none`)
+	fs.StringVar(&opts.DefaultName, "default-name", "", "name to greet when none is given, e.g. stranger (default: show usage)")
+	fs.Int64Var(&opts.MaxOutputBytes, "max-output-bytes", 0, maxOutputBytesUsage)
+	fs.BoolVar(&opts.Interactive, "interactive", false, "read names from stdin, one per line, until EOF or a blank line")
+	return fs
+}
+
+// maxOutputBytesUsage is the help text shared by greet and greet-all.
+const maxOutputBytesUsage = "fail once total output would exceed N bytes, newlines included (default: unlimited)"
+
+// newBatchFlagSet defines the greet-all flags on a fresh FlagSet bound to opts.
+func newBatchFlagSet(opts *BatchOptions) *flag.FlagSet {
+	fs := flag.NewFlagSet(opts.ProgramName, flag.ContinueOnError)
+	fs.Int64Var(&opts.MaxOutputBytes, "max-output-bytes", 0, maxOutputBytesUsage)
+	return fs
+}
+
+// printFlagDefaults writes the flag help text to w.
+func printFlagDefaults(w io.Writer, programName string) {
+	fs := newFlagSet(&Options{ProgramName: programName})
+	fs.SetOutput(w)
+	fs.PrintDefaults()
+}
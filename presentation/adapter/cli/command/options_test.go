@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package command_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	clicmd "github.com/abitofhelp/hybrid_app_go/presentation/adapter/cli/command"
+)
+
+func TestParseOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    clicmd.Options
+		wantErr bool
+	}{
+		{"name only", []string{"greeter", "Alice"},
+			clicmd.Options{ProgramName: "greeter", Count: 1, Punctuation: "!", Name: "Alice"}, false},
+		{"dry-run long form", []string{"greeter", "--dry-run", "Alice"},
+			clicmd.Options{ProgramName: "greeter", Count: 1, Punctuation: "!", Name: "Alice", DryRun: true}, false},
+		{"dry-run short form", []string{"greeter", "-dry-run", "Alice"},
+			clicmd.Options{ProgramName: "greeter", Count: 1, Punctuation: "!", Name: "Alice", DryRun: true}, false},
+		{"verbose shorthand", []string{"greeter", "-v", "Alice"},
+			clicmd.Options{ProgramName: "greeter", Count: 1, Punctuation: "!", Name: "Alice", Verbose: true}, false},
+		{"quiet long form", []string{"greeter", "--quiet", "Alice"},
+			clicmd.Options{ProgramName: "greeter", Count: 1, Punctuation: "!", Name: "Alice", Quiet: true}, false},
+		{"verbose with quiet", []string{"greeter", "--verbose", "-q", "Alice"},
+			clicmd.Options{ProgramName: "greeter", Count: 1, Punctuation: "!", Verbose: true, Quiet: true}, true},
+		{"prefix and suffix", []string{"greeter", "--prefix", "[g] ", "--suffix=!!", "Alice"},
+			clicmd.Options{ProgramName: "greeter", Count: 1, Punctuation: "!", Name: "Alice", Prefix: "[g] ", Suffix: "!!"}, false},
+		{"format, output, timeout", []string{"greeter", "--format", "csv", "--output=stderr", "--timeout", "2s", "Alice"},
+			clicmd.Options{ProgramName: "greeter", Count: 1, Punctuation: "!", Name: "Alice", Format: "csv", Output: "stderr", Timeout: 2 * time.Second}, false},
+		{"version without name", []string{"greeter", "--version"},
+			clicmd.Options{ProgramName: "greeter", Count: 1, Punctuation: "!", ShowVersion: true}, false},
+		{"default name without name", []string{"greeter", "--default-name", "stranger"},
+			clicmd.Options{ProgramName: "greeter", Count: 1, Punctuation: "!", Name: "stranger", DefaultName: "stranger"}, false},
+		{"default name with name", []string{"greeter", "--default-name=stranger", "Alice"},
+			clicmd.Options{ProgramName: "greeter", Count: 1, Punctuation: "!", Name: "Alice", DefaultName: "stranger"}, false},
+		{"default name with two names", []string{"greeter", "--default-name=stranger", "Alice", "Bob"},
+			clicmd.Options{ProgramName: "greeter", Count: 1, Punctuation: "!", DefaultName: "stranger"}, true},
+		{"count", []string{"greeter", "--count", "3", "Alice"},
+			clicmd.Options{ProgramName: "greeter", Count: 3, Punctuation: "!", Name: "Alice"}, false},
+		{"count zero", []string{"greeter", "--count=0", "Alice"},
+			clicmd.Options{ProgramName: "greeter", Punctuation: "!"}, true},
+		{"count above max", []string{"greeter", "--count=101", "Alice"},
+			clicmd.Options{ProgramName: "greeter", Count: 101, Punctuation: "!"}, true},
+		{"count not a number", []string{"greeter", "--count=many", "Alice"},
+			clicmd.Options{ProgramName: "greeter", Punctuation: "!"}, true},
+		{"case", []string{"greeter", "--case=title", "bob smith"},
+			clicmd.Options{ProgramName: "greeter", Count: 1, Punctuation: "!", Case: "title", Name: "bob smith"}, false},
+		{"punctuation", []string{"greeter", "--punctuation=.", "Alice"},
+			clicmd.Options{ProgramName: "greeter", Count: 1, Punctuation: ".", Name: "Alice"}, false},
+		{"empty punctuation", []string{"greeter", "--punctuation=", "Alice"},
+			clicmd.Options{ProgramName: "greeter", Count: 1, Name: "Alice"}, false},
+		{"empty args", []string{},
+			clicmd.Options{ProgramName: "greeter", Count: 1, Punctuation: "!"}, true},
+		{"no name", []string{"greeter", "--dry-run"},
+			clicmd.Options{ProgramName: "greeter", Count: 1, Punctuation: "!", DryRun: true}, true},
+		{"two names", []string{"greeter", "Alice", "Bob"},
+			clicmd.Options{ProgramName: "greeter", Count: 1, Punctuation: "!"}, true},
+		{"unknown flag", []string{"greeter", "--nope", "Alice"},
+			clicmd.Options{ProgramName: "greeter", Count: 1, Punctuation: "!"}, true},
+		{"max output bytes", []string{"greeter", "--max-output-bytes=64", "Alice"},
+			clicmd.Options{ProgramName: "greeter", Count: 1, Punctuation: "!", Name: "Alice", MaxOutputBytes: 64}, false},
+		{"negative max output bytes", []string{"greeter", "--max-output-bytes=-1", "Alice"},
+			clicmd.Options{ProgramName: "greeter", Count: 1, Punctuation: "!", MaxOutputBytes: -1}, true},
+		{"interactive without name", []string{"greeter", "--interactive"},
+			clicmd.Options{ProgramName: "greeter", Count: 1, Punctuation: "!", Interactive: true}, false},
+		{"interactive with name", []string{"greeter", "--interactive", "Alice"},
+			clicmd.Options{ProgramName: "greeter", Count: 1, Punctuation: "!", Interactive: true}, true},
+		{"interactive with quiet and timeout", []string{"greeter", "--interactive", "-q", "--timeout=1s"},
+			clicmd.Options{ProgramName: "greeter", Count: 1, Punctuation: "!", Interactive: true, Quiet: true, Timeout: time.Second}, false},
+		{"interactive with dry-run", []string{"greeter", "--interactive", "--dry-run"},
+			clicmd.Options{ProgramName: "greeter", Count: 1, Punctuation: "!", Interactive: true, DryRun: true}, true},
+		{"interactive with prefix", []string{"greeter", "--prefix=> ", "--interactive"},
+			clicmd.Options{ProgramName: "greeter", Count: 1, Punctuation: "!", Interactive: true, Prefix: "> "}, true},
+		{"interactive with count", []string{"greeter", "--interactive", "--count=2"},
+			clicmd.Options{ProgramName: "greeter", Count: 2, Punctuation: "!", Interactive: true}, true},
+		{"interactive with default punctuation given", []string{"greeter", "--interactive", "--punctuation=!"},
+			clicmd.Options{ProgramName: "greeter", Count: 1, Punctuation: "!", Interactive: true}, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := clicmd.ParseOptions(tc.args)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("ParseOptions(%q) error = %v, wantErr %v", tc.args, err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("ParseOptions(%q) = %+v, want %+v", tc.args, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestParseBatchOptions(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    clicmd.BatchOptions
+		wantErr bool
+	}{
+		{"names only", []string{"greeter greet-all", "Alice", "", "Bob"},
+			clicmd.BatchOptions{ProgramName: "greeter greet-all", Names: []string{"Alice", "", "Bob"}}, false},
+		{"max output bytes", []string{"greeter greet-all", "--max-output-bytes", "100", "Alice"},
+			clicmd.BatchOptions{ProgramName: "greeter greet-all", Names: []string{"Alice"}, MaxOutputBytes: 100}, false},
+		{"flag after first name is a name", []string{"greeter greet-all", "Alice", "--max-output-bytes=1"},
+			clicmd.BatchOptions{ProgramName: "greeter greet-all", Names: []string{"Alice", "--max-output-bytes=1"}}, false},
+		{"negative max output bytes", []string{"greeter greet-all", "--max-output-bytes=-5", "Alice"},
+			clicmd.BatchOptions{ProgramName: "greeter greet-all", MaxOutputBytes: -5}, true},
+		{"no names", []string{"greeter greet-all", "--max-output-bytes=10"},
+			clicmd.BatchOptions{ProgramName: "greeter greet-all", MaxOutputBytes: 10}, true},
+		{"unknown flag", []string{"greeter greet-all", "--count=2", "Alice"},
+			clicmd.BatchOptions{ProgramName: "greeter greet-all"}, true},
+		{"empty args", []string{},
+			clicmd.BatchOptions{ProgramName: "greeter greet-all"}, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := clicmd.ParseBatchOptions(tc.args)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("ParseBatchOptions(%q) error = %v, wantErr %v", tc.args, err, tc.wantErr)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("ParseBatchOptions(%q) = %+v, want %+v", tc.args, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+module github.com/abitofhelp/hybrid_app_go/presentation/adapter/grpc
+
+go 1.23.0
+
+// gRPC driving adapter, a separate module so that the gRPC and protobuf
+// dependencies stay out of the dependency-free presentation module.
+// Like presentation, it depends ONLY on the application layer.
+
+require (
+	github.com/abitofhelp/hybrid_app_go/application v0.0.0
+	google.golang.org/grpc v1.75.1
+	google.golang.org/protobuf v1.36.6
+)
+
+require (
+	github.com/abitofhelp/hybrid_app_go/domain v0.0.0 // indirect
+	golang.org/x/net v0.41.0 // indirect
+	golang.org/x/sys v0.33.0 // indirect
+	golang.org/x/text v0.26.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20250707201910-8d1bb00bc6a7 // indirect
+)
+
+replace (
+	github.com/abitofhelp/hybrid_app_go/application => ../../../application
+	github.com/abitofhelp/hybrid_app_go/domain => ../../../domain
+)
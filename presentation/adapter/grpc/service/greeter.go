@@ -0,0 +1,207 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: service
+// Description: gRPC Greeter service driving the greet use case
+
+// Package service provides the gRPC driving adapter: a Greeter service
+// (proto/greeter/v1/greeter.proto) that translates each RPC into a
+// GreetCommand, calls the use case through its inbound port, and maps the
+// Result to a response message or a gRPC status.
+//
+// Architecture Notes:
+//   - Part of the PRESENTATION layer (driving/primary adapters)
+//   - Depends ONLY on application (ports, commands, error types)
+//   - Generic over inbound.GreetPort for STATIC DISPATCH, like the CLI
+//     commands and HTTP handlers
+//   - The use case writes through ResponseWriter, which captures the
+//     greeting into the RPC's response instead of stdout
+//
+// Usage:
+//
+//	uc := usecase.NewGreetUseCase(service.ResponseWriter{})
+//	srv := grpc.NewServer()
+//	service.RegisterGreeterServer(srv, service.NewGreeterServer(uc))
+//	srv.Serve(listener)
+package service
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/abitofhelp/hybrid_app_go/application/command"
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	"github.com/abitofhelp/hybrid_app_go/application/port/inbound"
+)
+
+// GreeterServiceName is the fully qualified service name from greeter.proto.
+const GreeterServiceName = "greeter.v1.Greeter"
+
+// greetMethod is the full RPC method name of Greeter.Greet.
+const greetMethod = "/" + GreeterServiceName + "/Greet"
+
+// GreeterServer is the server API for the Greeter service.
+type GreeterServer interface {
+	Greet(ctx context.Context, name *wrapperspb.StringValue) (*wrapperspb.StringValue, error)
+}
+
+// greeterServiceDesc describes the Greeter service to grpc.Server.
+var greeterServiceDesc = grpc.ServiceDesc{
+	ServiceName: GreeterServiceName,
+	HandlerType: (*GreeterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "Greet", Handler: greetHandler},
+	},
+	Metadata: "greeter/v1/greeter.proto",
+}
+
+// RegisterGreeterServer registers srv on s (e.g., a *grpc.Server).
+func RegisterGreeterServer(s grpc.ServiceRegistrar, srv GreeterServer) {
+	s.RegisterService(&greeterServiceDesc, srv)
+}
+
+// greetHandler decodes a Greet request and calls srv through interceptor.
+func greetHandler(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+	in := new(wrapperspb.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GreeterServer).Greet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: greetMethod}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return srv.(GreeterServer).Greet(ctx, req.(*wrapperspb.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GreeterClient is the client API for the Greeter service.
+type GreeterClient struct {
+	cc grpc.ClientConnInterface
+}
+
+// NewGreeterClient creates a GreeterClient over cc (e.g., a *grpc.ClientConn).
+func NewGreeterClient(cc grpc.ClientConnInterface) *GreeterClient {
+	return &GreeterClient{cc: cc}
+}
+
+// Greet calls Greeter.Greet.
+func (c *GreeterClient) Greet(ctx context.Context, name *wrapperspb.StringValue, opts ...grpc.CallOption) (*wrapperspb.StringValue, error) {
+	out := new(wrapperspb.StringValue)
+	if err := c.cc.Invoke(ctx, greetMethod, name, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Greeter implements GreeterServer over a greet use case.
+//
+// Implements: GreeterServer
+type Greeter[UC inbound.GreetPort] struct {
+	useCase UC
+}
+
+// NewGreeterServer creates a Greeter with an injected use case, whose
+// writer must be ResponseWriter for the greeting to reach the response.
+func NewGreeterServer[UC inbound.GreetPort](useCase UC) *Greeter[UC] {
+	return &Greeter[UC]{useCase: useCase}
+}
+
+// Greet greets the name in the request.
+//
+// The use case runs with the RPC context, so client deadlines and
+// cancellation apply.
+//
+// Contract:
+//   - Post: Returns the captured greeting (several writes are joined with
+//     newlines) if the use case returns Ok
+//   - Post: Otherwise returns a status with CodeFor(err) and the error
+//     message
+func (g *Greeter[UC]) Greet(ctx context.Context, name *wrapperspb.StringValue) (*wrapperspb.StringValue, error) {
+	resp := &response{}
+	result := g.useCase.Execute(withResponse(ctx, resp), command.NewGreetCommand(name.GetValue()))
+	if result.IsError() {
+		err := result.ErrorInfo()
+		return nil, status.Error(CodeFor(err), err.Message)
+	}
+	return wrapperspb.String(resp.text()), nil
+}
+
+// CodeFor maps an error to the gRPC status code, the gRPC counterpart of
+// the CLI's ExitCodeFor and the HTTP StatusFor.
+//
+// Mapping:
+//   - ValidationError     -> InvalidArgument: client error, fix the input
+//   - NotFoundError       -> NotFound
+//   - InfrastructureError -> Internal
+//   - Any other kind      -> Unknown
+func CodeFor(err apperr.ErrorType) codes.Code {
+	switch err.Kind {
+	case apperr.ValidationError:
+		return codes.InvalidArgument
+	case apperr.NotFoundError:
+		return codes.NotFound
+	case apperr.InfrastructureError:
+		return codes.Internal
+	default:
+		return codes.Unknown
+	}
+}
+
+// response collects the messages written during one RPC.
+type response struct {
+	mu       sync.Mutex
+	messages []string
+}
+
+func (r *response) append(message string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.messages = append(r.messages, message)
+}
+
+func (r *response) text() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return strings.Join(r.messages, "\n")
+}
+
+// responseKey is the context key for the current RPC's response.
+type responseKey struct{}
+
+// withResponse returns ctx carrying resp for ResponseWriter.
+func withResponse(ctx context.Context, resp *response) context.Context {
+	return context.WithValue(ctx, responseKey{}, resp)
+}
+
+// ResponseWriter is the WriterPort for use cases served over gRPC: it
+// captures each message into the response of the RPC whose context it is
+// given, so one use case instance serves concurrent RPCs.
+//
+// Implements: outbound.WriterPort
+type ResponseWriter struct{}
+
+// Write appends message to the current RPC's response.
+//
+// Contract:
+//   - Post: Returns Ok(Unit) after capturing message
+//   - Post: Returns Err(InfrastructureError) if ctx is cancelled or does
+//     not belong to a Greeter RPC
+func (ResponseWriter) Write(ctx context.Context, message string) apperr.Result[model.Unit] {
+	if err := ctx.Err(); err != nil {
+		return apperr.Err[model.Unit](apperr.NewInfrastructureError("write cancelled: " + err.Error()))
+	}
+	resp, ok := ctx.Value(responseKey{}).(*response)
+	if !ok {
+		return apperr.Err[model.Unit](apperr.NewInfrastructureError("write failed: no gRPC response in context"))
+	}
+	resp.append(message)
+	return apperr.Ok(model.UnitValue)
+}
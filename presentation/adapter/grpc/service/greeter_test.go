@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package service_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/abitofhelp/hybrid_app_go/application/command"
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	"github.com/abitofhelp/hybrid_app_go/application/port/outbound"
+	"github.com/abitofhelp/hybrid_app_go/presentation/adapter/grpc/service"
+)
+
+// stubGreetUseCase is a GreetPort test double. On success it writes the
+// greeting through writer, as GreetUseCase does; otherwise it returns err.
+type stubGreetUseCase struct {
+	writer outbound.WriterPort
+	err    *apperr.ErrorType
+}
+
+func (s *stubGreetUseCase) Execute(ctx context.Context, cmd command.GreetCommand) apperr.Result[model.Unit] {
+	if s.err != nil {
+		return apperr.Err[model.Unit](*s.err)
+	}
+	return s.writer.Write(ctx, "Hello, "+cmd.GetName()+"!")
+}
+
+// dialGreeter serves uc on an in-process bufconn listener and returns a
+// client connected to it.
+func dialGreeter(t *testing.T, uc *stubGreetUseCase) *service.GreeterClient {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	service.RegisterGreeterServer(srv, service.NewGreeterServer(uc))
+	go func() { _ = srv.Serve(listener) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("grpc.NewClient: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return service.NewGreeterClient(conn)
+}
+
+func TestGreeterGreet(t *testing.T) {
+	validation := apperr.NewValidationError("Person name cannot be empty")
+	notFound := apperr.NewNotFoundError("no such person")
+	infrastructure := apperr.NewInfrastructureError("write failed: broken pipe")
+
+	tests := []struct {
+		name     string
+		request  string
+		err      *apperr.ErrorType
+		wantCode codes.Code
+		wantBody string
+		wantMsg  string
+	}{
+		{name: "greeting in response", request: "Alice", wantCode: codes.OK, wantBody: "Hello, Alice!"},
+		{name: "validation error", request: "", err: &validation,
+			wantCode: codes.InvalidArgument, wantMsg: "Person name cannot be empty"},
+		{name: "not found error", request: "Bob", err: &notFound,
+			wantCode: codes.NotFound, wantMsg: "no such person"},
+		{name: "infrastructure error", request: "Bob", err: &infrastructure,
+			wantCode: codes.Internal, wantMsg: "write failed: broken pipe"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := dialGreeter(t, &stubGreetUseCase{writer: service.ResponseWriter{}, err: tt.err})
+
+			resp, err := client.Greet(context.Background(), wrapperspb.String(tt.request))
+			if got := status.Code(err); got != tt.wantCode {
+				t.Fatalf("status code = %v, want %v (err: %v)", got, tt.wantCode, err)
+			}
+			if tt.wantCode != codes.OK {
+				if got := status.Convert(err).Message(); got != tt.wantMsg {
+					t.Errorf("status message = %q, want %q", got, tt.wantMsg)
+				}
+				return
+			}
+			if resp.GetValue() != tt.wantBody {
+				t.Errorf("greeting = %q, want %q", resp.GetValue(), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestCodeFor(t *testing.T) {
+	tests := []struct {
+		kind apperr.ErrorKind
+		want codes.Code
+	}{
+		{apperr.ValidationError, codes.InvalidArgument},
+		{apperr.NotFoundError, codes.NotFound},
+		{apperr.InfrastructureError, codes.Internal},
+		{apperr.ErrorKind(99), codes.Unknown},
+	}
+	for _, tt := range tests {
+		if got := service.CodeFor(apperr.ErrorType{Kind: tt.kind}); got != tt.want {
+			t.Errorf("CodeFor(%v) = %v, want %v", tt.kind, got, tt.want)
+		}
+	}
+}
+
+func TestResponseWriterOutsideRPC(t *testing.T) {
+	result := service.ResponseWriter{}.Write(context.Background(), "Hello, Alice!")
+	if !result.IsError() || result.ErrorInfo().Kind != apperr.InfrastructureError {
+		t.Fatalf("Write outside an RPC = %+v, want InfrastructureError", result)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if result := (service.ResponseWriter{}).Write(ctx, "Hi"); !result.IsError() {
+		t.Fatalf("Write with cancelled ctx = Ok, want Err")
+	}
+}
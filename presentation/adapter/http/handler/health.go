@@ -0,0 +1,62 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: handler
+// Description: HTTP handler for the health check endpoint
+
+// Package handler provides HTTP driving adapters. Each handler translates
+// an HTTP request into an application command, calls the use case through
+// its inbound port, and maps the Result to an HTTP response.
+//
+// Architecture Notes:
+//   - Part of the PRESENTATION layer (driving/primary adapters)
+//   - Depends ONLY on application (ports, commands, error types)
+//   - Generic over inbound ports for STATIC DISPATCH, like the CLI commands
+//   - Uses net/http only (no web framework)
+//
+// Usage:
+//
+//	mux := http.NewServeMux()
+//	mux.Handle("/healthz", handler.NewHealthHandler(usecase.NewHealthUseCase()))
+package handler
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/abitofhelp/hybrid_app_go/application/command"
+	"github.com/abitofhelp/hybrid_app_go/application/port/inbound"
+)
+
+// HealthHandler serves liveness probes (conventionally mounted at /healthz).
+//
+// Responses:
+//   - 200 "ok" when the use case returns Ok
+//   - 503 with the error message when it returns Err
+//   - 405 for methods other than GET and HEAD
+type HealthHandler[UC inbound.HealthPort] struct {
+	useCase UC
+}
+
+// NewHealthHandler creates a HealthHandler with an injected use case.
+func NewHealthHandler[UC inbound.HealthPort](useCase UC) *HealthHandler[UC] {
+	return &HealthHandler[UC]{useCase: useCase}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *HealthHandler[UC]) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	result := h.useCase.Execute(r.Context(), command.NewHealthCommand())
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if result.IsError() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprintln(w, result.ErrorInfo().Message)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintln(w, "ok")
+}
@@ -0,0 +1,63 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/abitofhelp/hybrid_app_go/application/command"
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/application/model"
+	"github.com/abitofhelp/hybrid_app_go/presentation/adapter/http/handler"
+)
+
+// upHealth is a HealthPort test double that always reports healthy.
+type upHealth struct{}
+
+func (upHealth) Execute(_ context.Context, _ command.HealthCommand) apperr.Result[model.Unit] {
+	return apperr.Ok(model.UnitValue)
+}
+
+// downHealth is a HealthPort test double that always reports unhealthy.
+type downHealth struct{}
+
+func (downHealth) Execute(_ context.Context, _ command.HealthCommand) apperr.Result[model.Unit] {
+	return apperr.Err[model.Unit](apperr.NewInfrastructureError("database unreachable"))
+}
+
+func TestHealthHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		serve      http.Handler
+		method     string
+		wantStatus int
+		wantBody   string
+	}{
+		{"healthy GET", handler.NewHealthHandler(upHealth{}),
+			http.MethodGet, http.StatusOK, "ok\n"},
+		{"healthy HEAD", handler.NewHealthHandler(upHealth{}),
+			http.MethodHead, http.StatusOK, "ok\n"},
+		{"unhealthy", handler.NewHealthHandler(downHealth{}),
+			http.MethodGet, http.StatusServiceUnavailable, "database unreachable\n"},
+		{"wrong method", handler.NewHealthHandler(upHealth{}),
+			http.MethodPost, http.StatusMethodNotAllowed, "method not allowed\n"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			tc.serve.ServeHTTP(rec, httptest.NewRequest(tc.method, "/healthz", nil))
+
+			if rec.Code != tc.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tc.wantStatus)
+			}
+			if got := rec.Body.String(); got != tc.wantBody {
+				t.Errorf("body = %q, want %q", got, tc.wantBody)
+			}
+		})
+	}
+}
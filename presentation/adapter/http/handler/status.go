@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+// Package: handler
+// Description: HTTP status mapping for application errors
+
+package handler
+
+import (
+	"net/http"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+)
+
+// StatusFor maps an error to the HTTP response status, the HTTP
+// counterpart of the CLI's ExitCodeFor.
+//
+// Mapping:
+//   - ValidationError     -> 400 Bad Request: client error, fix the input
+//   - NotFoundError       -> 404 Not Found
+//   - InfrastructureError -> 503 Service Unavailable: retry may help
+//   - Any other kind      -> 500 Internal Server Error
+func StatusFor(err apperr.ErrorType) int {
+	switch err.Kind {
+	case apperr.ValidationError:
+		return http.StatusBadRequest
+	case apperr.NotFoundError:
+		return http.StatusNotFound
+	case apperr.InfrastructureError:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: BSD-3-Clause
+// Copyright (c) 2025 Michael Gardner, A Bit of Help, Inc.
+
+package handler_test
+
+import (
+	"net/http"
+	"testing"
+
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/presentation/adapter/http/handler"
+)
+
+func TestStatusFor(t *testing.T) {
+	tests := []struct {
+		name string
+		err  apperr.ErrorType
+		want int
+	}{
+		{"validation error", apperr.NewValidationError("bad name"), http.StatusBadRequest},
+		{"not found error", apperr.NewNotFoundError("no such person"), http.StatusNotFound},
+		{"infrastructure error", apperr.NewInfrastructureError("disk full"), http.StatusServiceUnavailable},
+		{"unknown kind", apperr.ErrorType{Kind: apperr.ErrorKind(99), Message: "?"}, http.StatusInternalServerError},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := handler.StatusFor(tc.err); got != tc.want {
+				t.Errorf("StatusFor(%v) = %d, want %d", tc.err, got, tc.want)
+			}
+		})
+	}
+}
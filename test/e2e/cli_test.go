@@ -102,7 +102,7 @@ func TestCLIGreeter(t *testing.T) {
 
 	cmd4 := exec.Command(binary, "")
 	output4, _ := cmd4.CombinedOutput()
-	tf.RunTest("Empty name - exit code is 1", cmd4.ProcessState.ExitCode() == 1)
+	tf.RunTest("Empty name - exit code is 2", cmd4.ProcessState.ExitCode() == 2)
 	tf.RunTest("Empty name - output contains error message",
 		strings.Contains(string(output4), "Error") ||
 			strings.Contains(string(output4), "empty"))
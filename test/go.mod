@@ -9,7 +9,10 @@ go 1.23
 // This is separate from /src modules which must have ZERO external module dependencies
 
 require (
+	github.com/abitofhelp/hybrid_app_go/application v0.0.0
+	github.com/abitofhelp/hybrid_app_go/bootstrap v0.0.0
 	github.com/abitofhelp/hybrid_app_go/domain v0.0.0
+	github.com/abitofhelp/hybrid_app_go/infrastructure v0.0.0
 	github.com/stretchr/testify v1.11.1
 )
 
@@ -7,6 +7,9 @@
 //
 // Integration tests verify the complete application flow by running
 // the actual greeter binary and checking stdout, stderr, and exit codes.
+// In-process tests drive the same wiring directly, injecting an output
+// buffer (cli.WithOutput) or a capture writer (adapter.NewCaptureWriter)
+// instead of redirecting os.Stdout.
 //
 // Run with: go test -v -tags=integration ./test/integration/...
 //
@@ -16,14 +19,21 @@ package integration
 
 import (
 	"bytes"
+	"context"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
 	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/abitofhelp/hybrid_app_go/application/command"
+	apperr "github.com/abitofhelp/hybrid_app_go/application/error"
+	"github.com/abitofhelp/hybrid_app_go/application/usecase"
+	"github.com/abitofhelp/hybrid_app_go/bootstrap/cli"
 	"github.com/abitofhelp/hybrid_app_go/domain/test"
+	"github.com/abitofhelp/hybrid_app_go/infrastructure/adapter"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -196,7 +206,7 @@ func TestGreeter_EmptyName_ValidationError(t *testing.T) {
 	registerTest(t)
 	stdout, stderr, exitCode := runGreeter("")
 
-	assert.Equal(t, 1, exitCode, "exit code should be 1")
+	assert.Equal(t, 2, exitCode, "exit code should be 2 (invalid input)")
 	assert.Empty(t, stdout, "stdout should be empty")
 	assert.Contains(t, stderr, "Error:", "stderr should contain error")
 	assert.Contains(t, stderr, "valid name", "stderr should mention valid name")
@@ -208,7 +218,7 @@ func TestGreeter_NameTooLong_ValidationError(t *testing.T) {
 	longName := strings.Repeat("x", 101)
 	stdout, stderr, exitCode := runGreeter(longName)
 
-	assert.Equal(t, 1, exitCode, "exit code should be 1")
+	assert.Equal(t, 2, exitCode, "exit code should be 2 (invalid input)")
 	assert.Empty(t, stdout, "stdout should be empty")
 	assert.Contains(t, stderr, "Error:", "stderr should contain error")
 }
@@ -276,8 +286,8 @@ func TestGreeter_InvalidInputs_TableDriven(t *testing.T) {
 	}{
 		{"no args", []string{}, 1, "Usage:"},
 		{"too many args", []string{"a", "b"}, 1, "Usage:"},
-		{"empty string", []string{""}, 1, "Error:"},
-		{"name too long", []string{strings.Repeat("x", 101)}, 1, "Error:"},
+		{"empty string", []string{""}, 2, "Error:"},
+		{"name too long", []string{strings.Repeat("x", 101)}, 2, "Error:"},
 	}
 
 	for _, tc := range tests {
@@ -291,3 +301,134 @@ func TestGreeter_InvalidInputs_TableDriven(t *testing.T) {
 		})
 	}
 }
+
+// ============================================================================
+// In-Process Tests (injected output, no binary or os.Stdout redirection)
+// ============================================================================
+
+func TestGreetFlow_RunWith_InjectedOutput(t *testing.T) {
+	tests := []struct {
+		name           string
+		args           []string
+		expectExitCode int
+		expectOutput   string
+	}{
+		{"valid name", []string{"Alice"}, 0, "Hello, Alice!\n"},
+		{"unicode name", []string{"José García"}, 0, "Hello, José García!\n"},
+		{"csv format", []string{"--format=csv", "O'Connor"}, 0, "\"Hello, O'Connor!\"\n"},
+		{"dry run", []string{"--dry-run", "Alice"}, 0, ""},
+		{"default name", []string{"--default-name=stranger"}, 0, "Hello, stranger!\n"},
+		{"count", []string{"--count", "2", "Alice"}, 0, "Hello, Alice!\nHello, Alice!\n"},
+		{"count zero", []string{"--count=0", "Alice"}, 1, ""},
+		{"count above max", []string{"--count=101", "Alice"}, 1, ""},
+		{"title case", []string{"--case", "title", "josé garcía"}, 0, "Hello, José García!\n"},
+		{"unsupported case", []string{"--case=shouty", "Alice"}, 2, ""},
+		{"period punctuation", []string{"--punctuation", ".", "Alice"}, 0, "Hello, Alice.\n"},
+		{"no punctuation", []string{"--punctuation=", "Alice"}, 0, "Hello, Alice\n"},
+		{"long punctuation", []string{"--punctuation=!!!", "Alice"}, 2, ""},
+		{"empty name", []string{""}, 2, ""},
+		{"no args", []string{}, 1, ""},
+		{"greet-all all valid", []string{"greet-all", "Alice", "Bob"}, 0, "Hello, Alice!\nHello, Bob!\n"},
+		{"greet-all mixed", []string{"greet-all", "Alice", "", "Bob"}, 3, "Hello, Alice!\nHello, Bob!\n"},
+		{"greet-all all invalid", []string{"greet-all", "", ""}, 2, ""},
+		{"locales", []string{"locales"}, 0, "en  Hello, Alice!\nes  ¡Hola, Alice!\nfr  Bonjour, Alice !\n"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			registerTest(t)
+			var out bytes.Buffer
+			cfg := cli.NewConfig(cli.WithOutput(&out))
+
+			exitCode := cli.RunWith(cfg, append([]string{"greeter"}, tc.args...))
+
+			assert.Equal(t, tc.expectExitCode, exitCode)
+			assert.Equal(t, tc.expectOutput, out.String())
+		})
+	}
+}
+
+// TestGreetFlow_RunWith_Interactive feeds --interactive from an injected
+// input until EOF or a blank line.
+func TestGreetFlow_RunWith_Interactive(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          string
+		expectExitCode int
+		expectOutput   string
+	}{
+		{"names then EOF", "Alice\nBob\n", 0, "Hello, Alice!\nHello, Bob!\n"},
+		{"blank line ends session", "Alice\n\nBob\n", 0, "Hello, Alice!\n"},
+		{"invalid name skipped", "Alice\nadmin\nBob\n", 3, "Hello, Alice!\nHello, Bob!\n"},
+		{"no input", "", 0, ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			registerTest(t)
+			var out bytes.Buffer
+			cfg := cli.NewConfig(cli.WithOutput(&out), cli.WithInput(strings.NewReader(tc.input)))
+
+			exitCode := cli.RunWith(cfg, []string{"greeter", "--interactive", "--quiet"})
+
+			assert.Equal(t, tc.expectExitCode, exitCode)
+			assert.Equal(t, tc.expectOutput, out.String())
+		})
+	}
+}
+
+// TestGreetFlow_RunContext_Cancelled drives the full wiring (bootstrap →
+// command → use case → writer) with a context that is already done.
+func TestGreetFlow_RunContext_Cancelled(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancelExpired()
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		args []string
+	}{
+		{"cancelled", cancelled, []string{"Alice"}},
+		{"deadline exceeded", expired, []string{"Alice"}},
+		{"cancelled csv", cancelled, []string{"--format=csv", "Alice"}},
+		{"cancelled count", cancelled, []string{"--count=3", "Alice"}},
+		{"cancelled subcommand", cancelled, []string{"greet", "Alice"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			registerTest(t)
+			var out bytes.Buffer
+			cfg := cli.NewConfig(cli.WithOutput(&out))
+
+			exitCode := cli.RunContext(tc.ctx, cfg, append([]string{"greeter"}, tc.args...))
+
+			assert.NotEqual(t, 0, exitCode)
+			assert.Empty(t, out.String())
+		})
+	}
+
+	// The same wiring with a live context still greets
+	registerTest(t)
+	var out bytes.Buffer
+	exitCode := cli.RunContext(context.Background(), cli.NewConfig(cli.WithOutput(&out)), []string{"greeter", "Alice"})
+	assert.Equal(t, 0, exitCode)
+	assert.Equal(t, "Hello, Alice!\n", out.String())
+}
+
+func TestGreetFlow_UseCase_CaptureWriter(t *testing.T) {
+	registerTest(t)
+	writer, captured := adapter.NewCaptureWriter()
+	uc := usecase.NewGreetUseCase(writer)
+
+	ok := uc.Execute(context.Background(), command.NewGreetCommand("Alice"))
+	require.True(t, ok.IsOk(), "valid name should succeed")
+
+	invalid := uc.Execute(context.Background(), command.NewGreetCommand(""))
+	require.True(t, invalid.IsError(), "empty name should fail")
+	assert.Equal(t, apperr.ValidationError, invalid.ErrorInfo().Kind)
+
+	assert.Equal(t, "Hello, Alice!\n", captured(), "only the valid greeting is captured")
+}